@@ -0,0 +1 @@
+data "stackit_availability_zones" "example" {}
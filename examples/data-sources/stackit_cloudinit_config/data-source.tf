@@ -0,0 +1,25 @@
+data "stackit_cloudinit_config" "example" {
+  part = [
+    {
+      content_type = "text/cloud-config"
+      content      = file("${path.module}/cloud-init.yaml")
+    },
+    {
+      content_type = "text/x-shellscript"
+      content      = file("${path.module}/setup.sh")
+      filename     = "setup.sh"
+    }
+  ]
+}
+
+resource "stackit_server" "example" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name         = "example-server"
+  machine_type = "g1.1"
+  boot_volume = {
+    size        = 64
+    source_type = "image"
+    source_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+  user_data = data.stackit_cloudinit_config.example.rendered
+}
@@ -0,0 +1,4 @@
+data "stackit_machine_types" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  filter     = "vcpus >= 4 && ram >= 16384"
+}
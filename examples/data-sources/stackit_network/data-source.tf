@@ -2,3 +2,12 @@ data "stackit_network" "example" {
   project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   network_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
 }
+
+# Look up a network by its name and labels
+data "stackit_network" "by_name" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "example-network"
+  labels = {
+    "env" = "prod"
+  }
+}
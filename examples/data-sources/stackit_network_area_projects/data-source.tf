@@ -0,0 +1,11 @@
+data "stackit_network_area_projects" "example" {
+  organization_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  network_area_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.project_ids, var.project_id)
+      error_message = "The project is not attached to the network area."
+    }
+  }
+}
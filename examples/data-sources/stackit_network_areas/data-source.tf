@@ -0,0 +1,6 @@
+data "stackit_network_areas" "example" {
+  organization_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  labels = {
+    "env" = "prod"
+  }
+}
@@ -0,0 +1,6 @@
+data "stackit_networks" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  labels = {
+    "env" = "prod"
+  }
+}
@@ -0,0 +1,7 @@
+data "stackit_public_ips" "unassigned" {
+  project_id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  unassigned_only = true
+  labels = {
+    "env" = "prod"
+  }
+}
@@ -0,0 +1,24 @@
+data "stackit_security_group_rules" "example" {
+  project_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  security_group_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Clone the rules into a security group of another project
+resource "stackit_security_group_rules" "clone" {
+  project_id        = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+  security_group_id = "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"
+  rules = [
+    for rule in data.stackit_security_group_rules.example.rules : {
+      direction   = rule.direction
+      description = rule.description
+      ether_type  = rule.ether_type
+      ip_range    = rule.ip_range
+      protocol = rule.protocol == null ? null : {
+        name   = rule.protocol.name
+        number = rule.protocol.name == null ? rule.protocol.number : null
+      }
+      port_range      = rule.port_range
+      icmp_parameters = rule.icmp_parameters
+    }
+  ]
+}
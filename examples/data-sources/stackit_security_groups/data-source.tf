@@ -0,0 +1,6 @@
+data "stackit_security_groups" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  labels = {
+    "env" = "prod"
+  }
+}
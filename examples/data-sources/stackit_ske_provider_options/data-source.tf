@@ -0,0 +1,7 @@
+data "stackit_ske_provider_options" "example" {}
+
+locals {
+  supported_kubernetes_versions = [
+    for v in data.stackit_ske_provider_options.example.kubernetes_versions : v.version if v.state == "supported"
+  ]
+}
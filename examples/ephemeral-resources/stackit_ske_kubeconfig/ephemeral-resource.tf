@@ -0,0 +1,19 @@
+ephemeral "stackit_ske_kubeconfig" "example" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  cluster_name = "example-cluster"
+  expiration   = 1800
+}
+
+provider "kubernetes" {
+  host                   = ephemeral.stackit_ske_kubeconfig.example.host
+  cluster_ca_certificate = ephemeral.stackit_ske_kubeconfig.example.cluster_ca_certificate
+  client_certificate     = ephemeral.stackit_ske_kubeconfig.example.client_certificate
+  client_key             = ephemeral.stackit_ske_kubeconfig.example.client_key
+}
+
+# Login kubeconfig, which authenticates with the STACKIT CLI instead of admin certificates
+ephemeral "stackit_ske_kubeconfig" "login" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  cluster_name = "example-cluster"
+  login        = true
+}
@@ -4,6 +4,17 @@ resource "stackit_key_pair" "keypair" {
   public_key = chomp(file("path/to/id_rsa.pub"))
 }
 
+# Generate a key pair, the private key is stored in the Terraform state
+resource "stackit_key_pair" "generated" {
+  name      = "example-generated-key-pair"
+  algorithm = "ED25519"
+}
+
+output "private_key" {
+  value     = stackit_key_pair.generated.private_key_openssh
+  sensitive = true
+}
+
 # Only use the import statement, if you want to import an existing key pair
 import {
   to = stackit_key_pair.import-example
@@ -0,0 +1,23 @@
+resource "stackit_network_area_routes" "example" {
+  organization_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  network_area_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  routes = [
+    {
+      prefix   = "192.168.0.0/24"
+      next_hop = "10.0.0.1"
+      labels = {
+        "key" = "value"
+      }
+    },
+    {
+      prefix   = "192.168.1.0/24"
+      next_hop = "10.0.0.2"
+    },
+  ]
+}
+
+# Only use the import statement, if you want to import the existing routes of a network area
+import {
+  to = stackit_network_area_routes.import-example
+  id = "${var.organization_id},${var.network_area_id}"
+}
@@ -0,0 +1,31 @@
+resource "stackit_server_group" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "example-web"
+  size       = 3
+  server_template = {
+    machine_type     = "g1.1"
+    image_id         = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    boot_volume_size = 64
+    network_id       = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+    keypair_name     = "example-key"
+    user_data        = file("${path.module}/cloud-init.yaml")
+    labels = {
+      "key" = "value"
+    }
+  }
+  rolling_update = {
+    max_unavailable           = 1
+    health_check_grace_period = "60s"
+    health_check_timeout      = "10m"
+  }
+  load_balancer = {
+    name             = "example-load-balancer"
+    target_pool_name = "example-target-pool"
+  }
+}
+
+# Only use the import statement, if you want to import an existing server group
+import {
+  to = stackit_server_group.import-example
+  id = "${var.project_id},${var.server_group_name}"
+}
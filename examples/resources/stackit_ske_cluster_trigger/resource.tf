@@ -0,0 +1,6 @@
+resource "stackit_ske_cluster_trigger" "example" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  cluster_name = "example-cluster"
+  operation    = "maintenance"
+  trigger      = "2025-01-01"
+}
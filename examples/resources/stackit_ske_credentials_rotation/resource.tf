@@ -0,0 +1,10 @@
+resource "time_rotating" "credentials" {
+  rotation_days = 90
+}
+
+resource "stackit_ske_credentials_rotation" "example" {
+  project_id       = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  cluster_name     = "example-cluster"
+  rotation_trigger = time_rotating.credentials.id
+  complete         = true
+}
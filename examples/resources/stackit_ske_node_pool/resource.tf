@@ -0,0 +1,39 @@
+resource "stackit_ske_cluster" "example" {
+  project_id             = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name                   = "example"
+  kubernetes_version_min = "x.x"
+  node_pools = [
+    {
+      name               = "np-system"
+      machine_type       = "x.x"
+      minimum            = "2"
+      maximum            = "3"
+      availability_zones = ["eu01-3"]
+    }
+  ]
+
+  # The node pools managed by stackit_ske_node_pool resources are part of the cluster spec
+  lifecycle {
+    ignore_changes = [node_pools]
+  }
+}
+
+resource "stackit_ske_node_pool" "example" {
+  project_id              = stackit_ske_cluster.example.project_id
+  cluster_name            = stackit_ske_cluster.example.name
+  name                    = "np-example"
+  machine_type            = "x.x"
+  minimum                 = "1"
+  maximum                 = "3"
+  availability_zones      = ["eu01-3"]
+  allow_system_components = false
+  labels = {
+    "team" = "example"
+  }
+}
+
+# Only use the import statement, if you want to import an existing ske node pool
+import {
+  to = stackit_ske_node_pool.import-example
+  id = "${var.project_id},${var.region},${var.ske_name},${var.node_pool_name}"
+}
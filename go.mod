@@ -37,7 +37,9 @@ require (
 	github.com/stackitcloud/stackit-sdk-go/services/ske v1.3.0
 	github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex v1.3.1
 	github.com/teambition/rrule-go v1.8.2
+	golang.org/x/crypto v0.41.0
 	golang.org/x/mod v0.27.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require github.com/hashicorp/go-retryablehttp v0.7.7 // indirect
@@ -84,7 +86,6 @@ require (
 	github.com/vmihailenco/msgpack/v5 v5.4.1 // indirect
 	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
 	github.com/zclconf/go-cty v1.16.3 // indirect
-	golang.org/x/crypto v0.41.0 // indirect
 	golang.org/x/net v0.43.0 // indirect
 	golang.org/x/sync v0.16.0 // indirect
 	golang.org/x/sys v0.35.0 // indirect
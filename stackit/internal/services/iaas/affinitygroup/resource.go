@@ -33,6 +33,8 @@ var (
 	_ resource.Resource                = &affinityGroupResource{}
 	_ resource.ResourceWithConfigure   = &affinityGroupResource{}
 	_ resource.ResourceWithImportState = &affinityGroupResource{}
+
+	policyOptions = []string{"hard-affinity", "hard-anti-affinity", "soft-affinity", "soft-anti-affinity"}
 )
 
 // Model is the provider's internal model
@@ -124,12 +126,14 @@ func (r *affinityGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 				},
 			},
 			"policy": schema.StringAttribute{
-				Description: "The policy of the affinity group.",
+				Description: "The policy of the affinity group. " + utils.SupportedValuesDocumentation(policyOptions),
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
-				Validators: []validator.String{},
+				Validators: []validator.String{
+					stringvalidator.OneOf(policyOptions...),
+				},
 			},
 			"members": schema.ListAttribute{
 				Description: "The servers that are part of the affinity group.",
@@ -0,0 +1,213 @@
+package affinitygroups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &affinityGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &affinityGroupsDataSource{}
+)
+
+type DataSourceModel struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	ProjectId      types.String `tfsdk:"project_id"`
+	AffinityGroups types.List   `tfsdk:"affinity_groups"`
+}
+
+// Types corresponding to a single element of DataSourceModel.AffinityGroups
+var affinityGroupTypes = map[string]attr.Type{
+	"affinity_group_id": types.StringType,
+	"name":              types.StringType,
+	"policy":            types.StringType,
+	"members":           types.ListType{ElemType: types.StringType},
+}
+
+// NewAffinityGroupsDataSource is a helper function to simplify the provider implementation.
+func NewAffinityGroupsDataSource() datasource.DataSource {
+	return &affinityGroupsDataSource{}
+}
+
+// affinityGroupsDataSource is the data source implementation.
+type affinityGroupsDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *affinityGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_affinity_groups"
+}
+
+func (d *affinityGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *affinityGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Affinity groups datasource schema. Lists the affinity groups of a project. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal datasource ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID for which the affinity groups are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"affinity_groups": schema.ListNestedAttribute{
+				Description: "List of affinity groups, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"affinity_group_id": schema.StringAttribute{
+							Description: "The affinity group ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the affinity group.",
+							Computed:    true,
+						},
+						"policy": schema.StringAttribute{
+							Description: "The policy of the affinity group.",
+							Computed:    true,
+						},
+						"members": schema.ListAttribute{
+							Description: "The servers that are part of the affinity group.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *affinityGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	affinityGroupsResp, err := d.client.ListAffinityGroups(ctx, projectId).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading affinity groups",
+			fmt.Sprintf("Affinity groups cannot be listed for project %q.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapDataSourceFields(ctx, affinityGroupsResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading affinity groups", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Affinity groups read")
+}
+
+func mapDataSourceFields(ctx context.Context, affinityGroupsResp *iaas.AffinityGroupListResponse, model *DataSourceModel) error {
+	if affinityGroupsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString())
+
+	affinityGroups := make([]iaas.AffinityGroup, len(affinityGroupsResp.GetItems()))
+	copy(affinityGroups, affinityGroupsResp.GetItems())
+	// Sort to get a stable result, the API doesn't guarantee any order
+	sort.SliceStable(affinityGroups, func(i, j int) bool {
+		if affinityGroups[i].GetName() != affinityGroups[j].GetName() {
+			return affinityGroups[i].GetName() < affinityGroups[j].GetName()
+		}
+		return affinityGroups[i].GetId() < affinityGroups[j].GetId()
+	})
+
+	affinityGroupsList := []attr.Value{}
+	for i := range affinityGroups {
+		affinityGroup := affinityGroups[i]
+
+		members := types.ListNull(types.StringType)
+		if affinityGroup.Members != nil {
+			var diags diag.Diagnostics
+			members, diags = types.ListValueFrom(ctx, types.StringType, *affinityGroup.Members)
+			if diags.HasError() {
+				return fmt.Errorf("mapping members of index %d: %w", i, core.DiagsToError(diags))
+			}
+		}
+
+		affinityGroupTF, diags := types.ObjectValue(affinityGroupTypes, map[string]attr.Value{
+			"affinity_group_id": types.StringPointerValue(affinityGroup.Id),
+			"name":              types.StringPointerValue(affinityGroup.Name),
+			"policy":            types.StringPointerValue(affinityGroup.Policy),
+			"members":           members,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		affinityGroupsList = append(affinityGroupsList, affinityGroupTF)
+	}
+
+	affinityGroupsTF, diags := types.ListValue(types.ObjectType{AttrTypes: affinityGroupTypes}, affinityGroupsList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.AffinityGroups = affinityGroupsTF
+	return nil
+}
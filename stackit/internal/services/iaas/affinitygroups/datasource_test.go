@@ -0,0 +1,103 @@
+package affinitygroups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.AffinityGroupListResponse
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+			},
+			&iaas.AffinityGroupListResponse{},
+			DataSourceModel{
+				Id:             types.StringValue("pid"),
+				ProjectId:      types.StringValue("pid"),
+				AffinityGroups: types.ListValueMust(types.ObjectType{AttrTypes: affinityGroupTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"simple_values_sorted",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+			},
+			&iaas.AffinityGroupListResponse{
+				Items: &[]iaas.AffinityGroup{
+					{
+						Id:      utils.Ptr("agid-2"),
+						Name:    utils.Ptr("web"),
+						Policy:  utils.Ptr("soft-anti-affinity"),
+						Members: &[]string{"sid-1", "sid-2"},
+					},
+					{
+						Id:     utils.Ptr("agid-1"),
+						Name:   utils.Ptr("db"),
+						Policy: utils.Ptr("hard-anti-affinity"),
+					},
+				},
+			},
+			DataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				AffinityGroups: types.ListValueMust(types.ObjectType{AttrTypes: affinityGroupTypes}, []attr.Value{
+					types.ObjectValueMust(affinityGroupTypes, map[string]attr.Value{
+						"affinity_group_id": types.StringValue("agid-1"),
+						"name":              types.StringValue("db"),
+						"policy":            types.StringValue("hard-anti-affinity"),
+						"members":           types.ListNull(types.StringType),
+					}),
+					types.ObjectValueMust(affinityGroupTypes, map[string]attr.Value{
+						"affinity_group_id": types.StringValue("agid-2"),
+						"name":              types.StringValue("web"),
+						"policy":            types.StringValue("soft-anti-affinity"),
+						"members": types.ListValueMust(types.StringType, []attr.Value{
+							types.StringValue("sid-1"),
+							types.StringValue("sid-2"),
+						}),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			DataSourceModel{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package availabilityzone
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &availabilityZonesDataSource{}
+	_ datasource.DataSourceWithConfigure = &availabilityZonesDataSource{}
+)
+
+type DataSourceModel struct {
+	Id                types.String `tfsdk:"id"` // needed by TF
+	AvailabilityZones types.List   `tfsdk:"availability_zones"`
+}
+
+// NewAvailabilityZonesDataSource is a helper function to simplify the provider implementation.
+func NewAvailabilityZonesDataSource() datasource.DataSource {
+	return &availabilityZonesDataSource{}
+}
+
+// availabilityZonesDataSource is the data source implementation.
+type availabilityZonesDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *availabilityZonesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_availability_zones"
+}
+
+func (d *availabilityZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *availabilityZonesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "A list of all availability zones of the region. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal datasource ID. It takes the values of \"`availability_zones`\".",
+				Computed:    true,
+			},
+			"availability_zones": schema.ListAttribute{
+				Description: "The names of the availability zones, sorted alphabetically.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *availabilityZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	availabilityZonesResp, err := d.client.ListAvailabilityZonesExecute(ctx)
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading availability zones",
+			"Availability zones cannot be found",
+			map[int]string{
+				http.StatusForbidden: "Forbidden access",
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapDataSourceFields(ctx, availabilityZonesResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading availability zones", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Availability zones read")
+}
+
+func mapDataSourceFields(ctx context.Context, availabilityZonesResp *iaas.AvailabilityZoneListResponse, model *DataSourceModel) error {
+	if availabilityZonesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if availabilityZonesResp.Items == nil {
+		return fmt.Errorf("items input is nil")
+	}
+
+	availabilityZones := make([]string, len(*availabilityZonesResp.Items))
+	copy(availabilityZones, *availabilityZonesResp.Items)
+	// Sort to prevent unnecessary recreation of dependent resources due to order changes.
+	sort.Strings(availabilityZones)
+
+	model.Id = utils.BuildInternalTerraformId(availabilityZones...)
+
+	availabilityZonesTF, diags := types.ListValueFrom(ctx, types.StringType, availabilityZones)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.AvailabilityZones = availabilityZonesTF
+	return nil
+}
@@ -0,0 +1,69 @@
+package availabilityzone
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.AvailabilityZoneListResponse
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"simple_values_sorted",
+			DataSourceModel{},
+			&iaas.AvailabilityZoneListResponse{
+				Items: &[]string{"eu01-3", "eu01-1", "eu01-2"},
+			},
+			DataSourceModel{
+				Id: types.StringValue("eu01-1,eu01-2,eu01-3"),
+				AvailabilityZones: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("eu01-1"),
+					types.StringValue("eu01-2"),
+					types.StringValue("eu01-3"),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			DataSourceModel{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+		{
+			"items_nil_fail",
+			DataSourceModel{},
+			&iaas.AvailabilityZoneListResponse{},
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package cloudinit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+const (
+	defaultBoundary    = "MIMEBOUNDARY"
+	defaultContentType = "text/cloud-config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &cloudInitConfigDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &cloudInitConfigDataSource{}
+)
+
+type DataSourceModel struct {
+	Id           types.String `tfsdk:"id"` // needed by TF
+	Gzip         types.Bool   `tfsdk:"gzip"`
+	Base64Encode types.Bool   `tfsdk:"base64_encode"`
+	Boundary     types.String `tfsdk:"boundary"`
+	Parts        types.List   `tfsdk:"part"`
+	Rendered     types.String `tfsdk:"rendered"`
+}
+
+// Struct corresponding to a single element of DataSourceModel.Parts
+type partModel struct {
+	ContentType types.String `tfsdk:"content_type"`
+	Content     types.String `tfsdk:"content"`
+	Filename    types.String `tfsdk:"filename"`
+	MergeType   types.String `tfsdk:"merge_type"`
+}
+
+// NewCloudInitConfigDataSource is a helper function to simplify the provider implementation.
+func NewCloudInitConfigDataSource() datasource.DataSource {
+	return &cloudInitConfigDataSource{}
+}
+
+// cloudInitConfigDataSource is the data source implementation.
+type cloudInitConfigDataSource struct{}
+
+// Metadata returns the data source type name.
+func (d *cloudInitConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudinit_config"
+}
+
+// Schema defines the schema for the data source.
+func (d *cloudInitConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Renders a multipart cloud-init configuration from cloud-configs and scripts, which can be passed as `user_data` to servers. " +
+		"By default the result is gzip compressed and base64 encoded, which is passed on unchanged to the API and keeps large configurations below the user data size limit."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal datasource ID. It is the SHA-256 hash of the rendered configuration.",
+				Computed:    true,
+			},
+			"gzip": schema.BoolAttribute{
+				Description: "Whether the rendered configuration is gzip compressed. Requires `base64_encode`. Defaults to `true`.",
+				Optional:    true,
+			},
+			"base64_encode": schema.BoolAttribute{
+				Description: "Whether the rendered configuration is base64 encoded. Defaults to `true`.",
+				Optional:    true,
+			},
+			"boundary": schema.StringAttribute{
+				Description: fmt.Sprintf("The boundary which separates the MIME parts. Defaults to `%s`.", defaultBoundary),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 70),
+				},
+			},
+			"part": schema.ListNestedAttribute{
+				Description: "The parts of the configuration, in the order in which they are processed by cloud-init.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"content_type": schema.StringAttribute{
+							Description: fmt.Sprintf("The MIME type of the part, e.g. `text/cloud-config` or `text/x-shellscript`. Parts of type `text/cloud-config` must be valid YAML. Defaults to `%s`.", defaultContentType),
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"content": schema.StringAttribute{
+							Description: "The content of the part.",
+							Required:    true,
+						},
+						"filename": schema.StringAttribute{
+							Description: "The filename which is reported for the part.",
+							Optional:    true,
+						},
+						"merge_type": schema.StringAttribute{
+							Description: "The merge type which controls how cloud-init merges the part with previous parts, e.g. `list(append)+dict(no_replace,recurse_list)+str()`.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"rendered": schema.StringAttribute{
+				Description: "The rendered cloud-init configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the combination of the encoding settings and the YAML of cloud-config parts.
+func (d *cloudInitConfigDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gzipEnabled := model.Gzip.IsNull() || model.Gzip.ValueBool()
+	base64Disabled := !model.Base64Encode.IsNull() && !model.Base64Encode.ValueBool()
+	if !model.Gzip.IsUnknown() && !model.Base64Encode.IsUnknown() && gzipEnabled && base64Disabled {
+		resp.Diagnostics.AddAttributeError(path.Root("base64_encode"), "Invalid configuration", "Gzip compressed output must be base64 encoded. Set `gzip` to `false` to render plain text.")
+	}
+
+	if model.Parts.IsNull() || model.Parts.IsUnknown() {
+		return
+	}
+	parts := []partModel{}
+	resp.Diagnostics.Append(model.Parts.ElementsAs(ctx, &parts, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, part := range parts {
+		if part.Content.IsUnknown() || part.ContentType.IsUnknown() {
+			continue
+		}
+		if contentType(part) != defaultContentType {
+			continue
+		}
+		if err := utils.CheckCloudConfig([]byte(part.Content.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("part").AtListIndex(i).AtName("content"), "Invalid cloud-config", err.Error())
+		}
+	}
+}
+
+// Read renders the cloud-init configuration.
+func (d *cloudInitConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rendered, err := render(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error rendering cloud-init configuration", err.Error())
+		return
+	}
+	sum := sha256.Sum256([]byte(rendered))
+	model.Id = types.StringValue(hex.EncodeToString(sum[:]))
+	model.Rendered = types.StringValue(rendered)
+
+	if err := utils.CheckUserData(rendered); err != nil {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "The rendered cloud-init configuration can't be used as server user data", err.Error())
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Cloud-init configuration rendered")
+}
+
+// render builds the multipart MIME archive of the parts and applies the configured encoding
+func render(ctx context.Context, model *DataSourceModel) (string, error) {
+	if model == nil {
+		return "", fmt.Errorf("nil model")
+	}
+	parts := []partModel{}
+	diags := model.Parts.ElementsAs(ctx, &parts, false)
+	if diags.HasError() {
+		return "", fmt.Errorf("converting parts: %w", core.DiagsToError(diags))
+	}
+	gzipEnabled := model.Gzip.IsNull() || model.Gzip.ValueBool()
+	base64Enabled := model.Base64Encode.IsNull() || model.Base64Encode.ValueBool()
+	if gzipEnabled && !base64Enabled {
+		return "", fmt.Errorf("gzip compressed output must be base64 encoded")
+	}
+	boundary := defaultBoundary
+	if !model.Boundary.IsNull() {
+		boundary = model.Boundary.ValueString()
+	}
+
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return "", fmt.Errorf("setting boundary: %w", err)
+	}
+	fmt.Fprintf(&buffer, "Content-Type: multipart/mixed; boundary=%q\r\nMIME-Version: 1.0\r\n\r\n", boundary)
+
+	for i, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", contentType(part))
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("Mime-Version", "1.0")
+		if !part.Filename.IsNull() {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", part.Filename.ValueString()))
+		}
+		if !part.MergeType.IsNull() {
+			header.Set("X-Merge-Type", part.MergeType.ValueString())
+		}
+		partWriter, err := writer.CreatePart(header)
+		if err != nil {
+			return "", fmt.Errorf("creating part %d: %w", i, err)
+		}
+		if _, err := partWriter.Write([]byte(part.Content.ValueString())); err != nil {
+			return "", fmt.Errorf("writing part %d: %w", i, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("closing multipart archive: %w", err)
+	}
+
+	output := buffer.Bytes()
+	if gzipEnabled {
+		var compressed bytes.Buffer
+		gzipWriter := gzip.NewWriter(&compressed)
+		if _, err := gzipWriter.Write(output); err != nil {
+			return "", fmt.Errorf("compressing: %w", err)
+		}
+		if err := gzipWriter.Close(); err != nil {
+			return "", fmt.Errorf("compressing: %w", err)
+		}
+		output = compressed.Bytes()
+	}
+	if base64Enabled {
+		return base64.StdEncoding.EncodeToString(output), nil
+	}
+	return string(output), nil
+}
+
+func contentType(part partModel) string {
+	if part.ContentType.IsNull() {
+		return defaultContentType
+	}
+	return part.ContentType.ValueString()
+}
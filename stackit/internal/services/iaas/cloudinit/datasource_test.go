@@ -0,0 +1,147 @@
+package cloudinit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+var partTypes = map[string]attr.Type{
+	"content_type": types.StringType,
+	"content":      types.StringType,
+	"filename":     types.StringType,
+	"merge_type":   types.StringType,
+}
+
+func partsValue(parts ...map[string]attr.Value) types.List {
+	values := []attr.Value{}
+	for _, part := range parts {
+		values = append(values, types.ObjectValueMust(partTypes, part))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: partTypes}, values)
+}
+
+func TestRender(t *testing.T) {
+	cloudConfig := map[string]attr.Value{
+		"content_type": types.StringNull(),
+		"content":      types.StringValue("#cloud-config\npackages:\n  - nginx\n"),
+		"filename":     types.StringNull(),
+		"merge_type":   types.StringNull(),
+	}
+	script := map[string]attr.Value{
+		"content_type": types.StringValue("text/x-shellscript"),
+		"content":      types.StringValue("#!/bin/bash\necho hello\n"),
+		"filename":     types.StringValue("hello.sh"),
+		"merge_type":   types.StringValue("list(append)"),
+	}
+	expectedMultipart := "Content-Type: multipart/mixed; boundary=\"B\"\r\nMIME-Version: 1.0\r\n\r\n" +
+		"--B\r\n" +
+		"Content-Transfer-Encoding: 7bit\r\n" +
+		"Content-Type: text/cloud-config\r\n" +
+		"Mime-Version: 1.0\r\n" +
+		"\r\n" +
+		"#cloud-config\npackages:\n  - nginx\n\r\n" +
+		"--B\r\n" +
+		"Content-Disposition: attachment; filename=\"hello.sh\"\r\n" +
+		"Content-Transfer-Encoding: 7bit\r\n" +
+		"Content-Type: text/x-shellscript\r\n" +
+		"Mime-Version: 1.0\r\n" +
+		"X-Merge-Type: list(append)\r\n" +
+		"\r\n" +
+		"#!/bin/bash\necho hello\n\r\n" +
+		"--B--\r\n"
+
+	tests := []struct {
+		description string
+		input       *DataSourceModel
+		expected    string
+		isValid     bool
+	}{
+		{
+			"plain_text",
+			&DataSourceModel{
+				Gzip:         types.BoolValue(false),
+				Base64Encode: types.BoolValue(false),
+				Boundary:     types.StringValue("B"),
+				Parts:        partsValue(cloudConfig, script),
+			},
+			expectedMultipart,
+			true,
+		},
+		{
+			"gzip_base64_by_default",
+			&DataSourceModel{
+				Gzip:         types.BoolNull(),
+				Base64Encode: types.BoolNull(),
+				Boundary:     types.StringValue("B"),
+				Parts:        partsValue(cloudConfig, script),
+			},
+			expectedMultipart,
+			true,
+		},
+		{
+			"base64_only",
+			&DataSourceModel{
+				Gzip:         types.BoolValue(false),
+				Base64Encode: types.BoolNull(),
+				Boundary:     types.StringValue("B"),
+				Parts:        partsValue(cloudConfig, script),
+			},
+			expectedMultipart,
+			true,
+		},
+		{
+			"gzip_without_base64",
+			&DataSourceModel{
+				Gzip:         types.BoolNull(),
+				Base64Encode: types.BoolValue(false),
+				Boundary:     types.StringNull(),
+				Parts:        partsValue(cloudConfig),
+			},
+			"",
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := render(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if !tt.isValid {
+				return
+			}
+
+			// Encoded output is decoded the same way the server resource does it
+			content, encoded, err := utils.DecodeUserData(output)
+			if err != nil {
+				t.Fatalf("Decoding rendered output: %v", err)
+			}
+			expectEncoded := tt.input.Base64Encode.IsNull() || tt.input.Base64Encode.ValueBool()
+			if encoded != expectEncoded {
+				t.Fatalf("Expected encoded to be %t, got %t", expectEncoded, encoded)
+			}
+			diff := cmp.Diff(string(content), tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+			if err := utils.CheckUserData(output); err != nil {
+				t.Fatalf("Rendered output is not valid user data: %v", err)
+			}
+		})
+	}
+}
@@ -21,6 +21,16 @@ var (
 	_ datasource.DataSource = &keyPairDataSource{}
 )
 
+type DataSourceModel struct {
+	Id                types.String `tfsdk:"id"` // needed by TF
+	Name              types.String `tfsdk:"name"`
+	PublicKey         types.String `tfsdk:"public_key"`
+	Algorithm         types.String `tfsdk:"algorithm"`
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+	FingerprintSha256 types.String `tfsdk:"fingerprint_sha256"`
+	Labels            types.Map    `tfsdk:"labels"`
+}
+
 // NewVolumeDataSource is a helper function to simplify the provider implementation.
 func NewKeyPairDataSource() datasource.DataSource {
 	return &keyPairDataSource{}
@@ -70,8 +80,16 @@ func (r *keyPairDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "A string representation of the public SSH key. E.g., `ssh-rsa <key_data>` or `ssh-ed25519 <key-data>`.",
 				Computed:    true,
 			},
+			"algorithm": schema.StringAttribute{
+				Description: "The algorithm of the public SSH key, e.g. `ED25519`, `RSA` or `ECDSA`.",
+				Computed:    true,
+			},
 			"fingerprint": schema.StringAttribute{
-				Description: "The fingerprint of the public SSH key.",
+				Description: "The MD5 fingerprint of the public SSH key.",
+				Computed:    true,
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				Description: "The SHA-256 fingerprint of the public SSH key, in the format used by OpenSSH, e.g. `SHA256:<base64_data>`.",
 				Computed:    true,
 			},
 			"labels": schema.MapAttribute{
@@ -85,7 +103,7 @@ func (r *keyPairDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 
 // Read refreshes the Terraform state with the latest data.
 func (r *keyPairDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model DataSourceModel
 	diags := req.Config.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -109,7 +127,7 @@ func (r *keyPairDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, keypairResp, &model)
+	err = mapDataSourceFields(ctx, keypairResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading key pair", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -122,3 +140,30 @@ func (r *keyPairDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 	tflog.Info(ctx, "Key pair read")
 }
+
+func mapDataSourceFields(ctx context.Context, keyPairResp *iaas.Keypair, model *DataSourceModel) error {
+	if keyPairResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	resourceModel := &Model{
+		Name:   model.Name,
+		Labels: model.Labels,
+	}
+	err := mapFields(ctx, keyPairResp, resourceModel)
+	if err != nil {
+		return err
+	}
+
+	model.Id = resourceModel.Id
+	model.Name = resourceModel.Name
+	model.PublicKey = resourceModel.PublicKey
+	model.Algorithm = resourceModel.Algorithm
+	model.Fingerprint = resourceModel.Fingerprint
+	model.FingerprintSha256 = resourceModel.FingerprintSha256
+	model.Labels = resourceModel.Labels
+	return nil
+}
@@ -0,0 +1,82 @@
+package keypair
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.Keypair
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			DataSourceModel{
+				Name: types.StringValue("name"),
+			},
+			&iaas.Keypair{
+				Name: utils.Ptr("name"),
+			},
+			DataSourceModel{
+				Id:     types.StringValue("name"),
+				Name:   types.StringValue("name"),
+				Labels: types.MapNull(types.StringType),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			DataSourceModel{
+				Name: types.StringValue("name"),
+			},
+			&iaas.Keypair{
+				Name:        utils.Ptr("name"),
+				PublicKey:   utils.Ptr(testEd25519PublicKey),
+				Fingerprint: utils.Ptr("fingerprint"),
+			},
+			DataSourceModel{
+				Id:                types.StringValue("name"),
+				Name:              types.StringValue("name"),
+				PublicKey:         types.StringValue(testEd25519PublicKey),
+				Algorithm:         types.StringValue("ED25519"),
+				Fingerprint:       types.StringValue("fingerprint"),
+				FingerprintSha256: types.StringValue("SHA256:CoMQOhjFoMm6OC7opMu+XI5WR+w5dqXl3WXY+KRPd5g"),
+				Labels:            types.MapNull(types.StringType),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			DataSourceModel{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
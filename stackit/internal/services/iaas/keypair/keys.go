@@ -0,0 +1,83 @@
+package keypair
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	algorithmEd25519 = "ED25519"
+	algorithmRsa     = "RSA"
+
+	defaultRsaBits = 4096
+)
+
+// algorithmsByKeyType maps SSH public key types to the algorithm reported in the schema
+var algorithmsByKeyType = map[string]string{
+	ssh.KeyAlgoED25519:    algorithmEd25519,
+	ssh.KeyAlgoRSA:        algorithmRsa,
+	ssh.KeyAlgoECDSA256:   "ECDSA",
+	ssh.KeyAlgoECDSA384:   "ECDSA",
+	ssh.KeyAlgoECDSA521:   "ECDSA",
+	ssh.KeyAlgoSKED25519:  "ED25519_SK",
+	ssh.KeyAlgoSKECDSA256: "ECDSA_SK",
+}
+
+// generateKeyPair generates a key pair locally and returns the public key in authorized_keys format
+// and the private key in the OpenSSH PEM format
+func generateKeyPair(algorithm string, rsaBits int) (publicKey, privateKeyPEM string, err error) {
+	var cryptoPublicKey crypto.PublicKey
+	var cryptoPrivateKey crypto.PrivateKey
+	switch algorithm {
+	case algorithmEd25519:
+		cryptoPublicKey, cryptoPrivateKey, err = ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", "", fmt.Errorf("generating ed25519 key: %w", err)
+		}
+	case algorithmRsa:
+		rsaKey, err := rsa.GenerateKey(rand.Reader, rsaBits)
+		if err != nil {
+			return "", "", fmt.Errorf("generating RSA key: %w", err)
+		}
+		cryptoPublicKey, cryptoPrivateKey = &rsaKey.PublicKey, rsaKey
+	default:
+		return "", "", fmt.Errorf("unsupported algorithm %q", algorithm)
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(cryptoPublicKey)
+	if err != nil {
+		return "", "", fmt.Errorf("converting public key: %w", err)
+	}
+	privateKeyBlock, err := ssh.MarshalPrivateKey(cryptoPrivateKey, "")
+	if err != nil {
+		return "", "", fmt.Errorf("encoding private key: %w", err)
+	}
+	publicKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPublicKey)))
+	return publicKey, string(pem.EncodeToMemory(privateKeyBlock)), nil
+}
+
+// keyDetails returns the algorithm, the SHA-256 fingerprint and, for RSA keys, the key size of a public key in authorized_keys format
+func keyDetails(publicKey string) (algorithm, fingerprintSha256 string, rsaBits int, err error) {
+	sshPublicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", "", 0, fmt.Errorf("parsing public key: %w", err)
+	}
+
+	algorithm, ok := algorithmsByKeyType[sshPublicKey.Type()]
+	if !ok {
+		algorithm = sshPublicKey.Type()
+	}
+	if cryptoPublicKey, ok := sshPublicKey.(ssh.CryptoPublicKey); ok {
+		if rsaPublicKey, ok := cryptoPublicKey.CryptoPublicKey().(*rsa.PublicKey); ok {
+			rsaBits = rsaPublicKey.N.BitLen()
+		}
+	}
+	return algorithm, ssh.FingerprintSHA256(sshPublicKey), rsaBits, nil
+}
@@ -8,32 +8,46 @@ import (
 
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &keyPairResource{}
-	_ resource.ResourceWithConfigure   = &keyPairResource{}
-	_ resource.ResourceWithImportState = &keyPairResource{}
+	_ resource.Resource                     = &keyPairResource{}
+	_ resource.ResourceWithConfigure        = &keyPairResource{}
+	_ resource.ResourceWithImportState      = &keyPairResource{}
+	_ resource.ResourceWithConfigValidators = &keyPairResource{}
+	_ resource.ResourceWithValidateConfig   = &keyPairResource{}
+
+	generatedAlgorithms = []string{algorithmEd25519, algorithmRsa}
 )
 
 type Model struct {
-	Id          types.String `tfsdk:"id"` // needed by TF
-	Name        types.String `tfsdk:"name"`
-	PublicKey   types.String `tfsdk:"public_key"`
-	Fingerprint types.String `tfsdk:"fingerprint"`
-	Labels      types.Map    `tfsdk:"labels"`
+	Id                types.String `tfsdk:"id"` // needed by TF
+	Name              types.String `tfsdk:"name"`
+	PublicKey         types.String `tfsdk:"public_key"`
+	Algorithm         types.String `tfsdk:"algorithm"`
+	RsaBits           types.Int64  `tfsdk:"rsa_bits"`
+	PrivateKeyOpenSSH types.String `tfsdk:"private_key_openssh"`
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+	FingerprintSha256 types.String `tfsdk:"fingerprint_sha256"`
+	Labels            types.Map    `tfsdk:"labels"`
 }
 
 // NewKeyPairResource is a helper function to simplify the provider implementation.
@@ -66,9 +80,40 @@ func (r *keyPairResource) Configure(ctx context.Context, req resource.ConfigureR
 	tflog.Info(ctx, "iaas client configured")
 }
 
+// ConfigValidators validates the resource configuration
+func (r *keyPairResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("public_key"),
+			path.MatchRoot("algorithm"),
+		),
+	}
+}
+
+// ValidateConfig checks that the RSA key size is only set for generated RSA keys.
+func (r *keyPairResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.RsaBits.IsNull() || model.Algorithm.IsUnknown() {
+		return
+	}
+	if model.Algorithm.ValueString() != algorithmRsa {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rsa_bits"),
+			"Conflicting attribute configuration",
+			fmt.Sprintf("`rsa_bits` can only be set if `algorithm` is set to `%s`", algorithmRsa),
+		)
+	}
+}
+
 // Schema defines the schema for the resource.
 func (r *keyPairResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	description := "Key pair resource schema. Must have a `region` specified in the provider configuration. Allows uploading an SSH public key to be used for server authentication."
+	description := "Key pair resource schema. Must have a `region` specified in the provider configuration. Allows uploading an SSH public key to be used for server authentication. " +
+		"Alternatively, a key pair is generated by the provider if `algorithm` is set. The private key of a generated key pair is stored unencrypted in the Terraform state, which must be protected accordingly."
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: description + "\n\n" + exampleUsageWithServer,
@@ -90,14 +135,60 @@ func (r *keyPairResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"public_key": schema.StringAttribute{
-				Description: "A string representation of the public SSH key. E.g., `ssh-rsa <key_data>` or `ssh-ed25519 <key-data>`.",
-				Required:    true,
+				Description: "A string representation of the public SSH key. E.g., `ssh-rsa <key_data>` or `ssh-ed25519 <key-data>`. Either `public_key` or `algorithm` must be provided. Set to the generated public key if `algorithm` is provided.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"algorithm": schema.StringAttribute{
+				Description: "The algorithm of the key pair. If provided, a new key pair of this algorithm is generated by the provider instead of uploading `public_key`. " + utils.FormatPossibleValues(generatedAlgorithms...) + " Set to the algorithm of `public_key` otherwise.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					// algorithm is null for keys of unknown type and planned as unknown on every update,
+					// so only replace the key pair if the algorithm is configured
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(generatedAlgorithms...),
+				},
+			},
+			"rsa_bits": schema.Int64Attribute{
+				Description: fmt.Sprintf("The size of a generated RSA key in bits. Can only be set if `algorithm` is `%s`. Defaults to `%d`.", algorithmRsa, defaultRsaBits),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					// rsa_bits is null for all keys except RSA keys and planned as unknown on every update,
+					// so only replace the key pair if the key size is configured
+					int64planmodifier.RequiresReplaceIfConfigured(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(2048),
+					int64validator.AtMost(8192),
+				},
+			},
+			"private_key_openssh": schema.StringAttribute{
+				Description: "The private key of a generated key pair in OpenSSH PEM format. Only set if the key pair was generated by the provider, it can't be read back from the API, e.g. after an import.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"fingerprint": schema.StringAttribute{
-				Description: "The fingerprint of the public SSH key.",
+				Description: "The MD5 fingerprint of the public SSH key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				Description: "The SHA-256 fingerprint of the public SSH key, in the format used by OpenSSH, e.g. `SHA256:<base64_data>`.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -148,6 +239,23 @@ func (r *keyPairResource) Create(ctx context.Context, req resource.CreateRequest
 	name := model.Name.ValueString()
 	ctx = tflog.SetField(ctx, "name", name)
 
+	// Generate the key pair locally, the private key never leaves the provider
+	if !model.Algorithm.IsNull() && !model.Algorithm.IsUnknown() {
+		rsaBits := defaultRsaBits
+		if !model.RsaBits.IsNull() && !model.RsaBits.IsUnknown() {
+			rsaBits = int(model.RsaBits.ValueInt64())
+		}
+		publicKey, privateKey, err := generateKeyPair(model.Algorithm.ValueString(), rsaBits)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating key pair", fmt.Sprintf("Generating key pair: %v", err))
+			return
+		}
+		model.PublicKey = types.StringValue(publicKey)
+		model.PrivateKeyOpenSSH = types.StringValue(privateKey)
+	} else {
+		model.PrivateKeyOpenSSH = types.StringNull()
+	}
+
 	// Generate API request body from model
 	payload, err := toCreatePayload(ctx, &model)
 	if err != nil {
@@ -325,6 +433,21 @@ func mapFields(ctx context.Context, keyPairResp *iaas.Keypair, model *Model) err
 	model.PublicKey = types.StringPointerValue(keyPairResp.PublicKey)
 	model.Fingerprint = types.StringPointerValue(keyPairResp.Fingerprint)
 
+	model.Algorithm = types.StringNull()
+	model.RsaBits = types.Int64Null()
+	model.FingerprintSha256 = types.StringNull()
+	if keyPairResp.PublicKey != nil {
+		// The API only accepts valid keys, keys which can't be parsed locally are still mapped
+		algorithm, fingerprintSha256, rsaBits, err := keyDetails(*keyPairResp.PublicKey)
+		if err == nil {
+			model.Algorithm = types.StringValue(algorithm)
+			model.FingerprintSha256 = types.StringValue(fingerprintSha256)
+			if rsaBits > 0 {
+				model.RsaBits = types.Int64Value(int64(rsaBits))
+			}
+		}
+	}
+
 	var err error
 	model.Labels, err = iaasUtils.MapLabels(ctx, keyPairResp.Labels, model.Labels)
 	if err != nil {
@@ -2,13 +2,26 @@ package keypair
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	testEd25519PublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAII6dbNxAePeYmW9WKbSRoX7WhoMySVblOzpfeGVvWdkI"
+	testRsaPublicKey     = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDMKs6CNNbJkzd1wO4dMatZLcp9wO2LSEwwg17ofORRHjfo/MPcVaH6GI7vJGIaL6Zoc9JUVAGxzF0kl2CiZtaYSFO+EcRm0NGVySPDkCobStDD4VT3kpj9VUjDwam9Le4JXEOpBhKdha8yyLOQUN09IZv8bvafVoXyVenE/Gi30g9ASB2y9Txtd4ZSk2EkFou+kQgGFpUkGC6Ej7lwizH4L/+Q8k37Wqnf1YuQR6hEMfagySMrsGU7aC6thPQmfQNANpCfE0yyCNKwbtl4Fw4k4EZcZG6oPb2hSfyq9u5Zi5DbB1N2PMsJ8OWsrM3gd6NnwTUZ83eSZlahu1bb/65p"
 )
 
 func TestMapFields(t *testing.T) {
@@ -60,6 +73,30 @@ func TestMapFields(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"public_key_details",
+			Model{
+				Name:              types.StringValue("name"),
+				PrivateKeyOpenSSH: types.StringValue("private_key"),
+			},
+			&iaas.Keypair{
+				Name:        utils.Ptr("name"),
+				PublicKey:   utils.Ptr(testRsaPublicKey),
+				Fingerprint: utils.Ptr("fingerprint"),
+			},
+			Model{
+				Id:                types.StringValue("name"),
+				Name:              types.StringValue("name"),
+				PublicKey:         types.StringValue(testRsaPublicKey),
+				Algorithm:         types.StringValue("RSA"),
+				RsaBits:           types.Int64Value(2048),
+				PrivateKeyOpenSSH: types.StringValue("private_key"),
+				Fingerprint:       types.StringValue("fingerprint"),
+				FingerprintSha256: types.StringValue("SHA256:/6Pv9HfAUJQHuXcYEPe2m1zEczS4JbwSk25edvvQCDU"),
+				Labels:            types.MapNull(types.StringType),
+			},
+			true,
+		},
 		{
 			"empty_labels",
 			Model{
@@ -209,3 +246,259 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyDetails(t *testing.T) {
+	tests := []struct {
+		description               string
+		input                     string
+		expectedAlgorithm         string
+		expectedFingerprintSha256 string
+		expectedRsaBits           int
+		isValid                   bool
+	}{
+		{
+			"ed25519",
+			testEd25519PublicKey + " user@host",
+			"ED25519",
+			"SHA256:CoMQOhjFoMm6OC7opMu+XI5WR+w5dqXl3WXY+KRPd5g",
+			0,
+			true,
+		},
+		{
+			"rsa",
+			testRsaPublicKey,
+			"RSA",
+			"SHA256:/6Pv9HfAUJQHuXcYEPe2m1zEczS4JbwSk25edvvQCDU",
+			2048,
+			true,
+		},
+		{
+			"invalid_key",
+			"public_key",
+			"",
+			"",
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			algorithm, fingerprintSha256, rsaBits, err := keyDetails(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if algorithm != tt.expectedAlgorithm {
+					t.Fatalf("Expected algorithm %q, got %q", tt.expectedAlgorithm, algorithm)
+				}
+				if fingerprintSha256 != tt.expectedFingerprintSha256 {
+					t.Fatalf("Expected fingerprint %q, got %q", tt.expectedFingerprintSha256, fingerprintSha256)
+				}
+				if rsaBits != tt.expectedRsaBits {
+					t.Fatalf("Expected %d RSA bits, got %d", tt.expectedRsaBits, rsaBits)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateKeyPair(t *testing.T) {
+	tests := []struct {
+		description     string
+		algorithm       string
+		rsaBits         int
+		expectedRsaBits int
+		isValid         bool
+	}{
+		{
+			"ed25519",
+			algorithmEd25519,
+			defaultRsaBits,
+			0,
+			true,
+		},
+		{
+			"rsa",
+			algorithmRsa,
+			2048,
+			2048,
+			true,
+		},
+		{
+			"unsupported_algorithm",
+			"DSA",
+			0,
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			publicKey, privateKey, err := generateKeyPair(tt.algorithm, tt.rsaBits)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if !tt.isValid {
+				return
+			}
+
+			algorithm, _, rsaBits, err := keyDetails(publicKey)
+			if err != nil {
+				t.Fatalf("Generated public key can't be parsed: %v", err)
+			}
+			if algorithm != tt.algorithm {
+				t.Fatalf("Expected algorithm %q, got %q", tt.algorithm, algorithm)
+			}
+			if rsaBits != tt.expectedRsaBits {
+				t.Fatalf("Expected %d RSA bits, got %d", tt.expectedRsaBits, rsaBits)
+			}
+
+			signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+			if err != nil {
+				t.Fatalf("Generated private key can't be parsed: %v", err)
+			}
+			diff := cmp.Diff(strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))), publicKey)
+			if diff != "" {
+				t.Fatalf("Private key does not match public key: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanModifiers(t *testing.T) {
+	tests := []struct {
+		description     string
+		algorithmState  types.String
+		algorithmConfig types.String
+		rsaBitsState    types.Int64
+		rsaBitsConfig   types.Int64
+		requiresReplace bool
+	}{
+		{
+			"uploaded_ed25519_labels_update",
+			types.StringValue(algorithmEd25519),
+			types.StringNull(),
+			types.Int64Null(),
+			types.Int64Null(),
+			false,
+		},
+		{
+			"generated_ed25519_labels_update",
+			types.StringValue(algorithmEd25519),
+			types.StringValue(algorithmEd25519),
+			types.Int64Null(),
+			types.Int64Null(),
+			false,
+		},
+		{
+			"unknown_key_type_labels_update",
+			types.StringNull(),
+			types.StringNull(),
+			types.Int64Null(),
+			types.Int64Null(),
+			false,
+		},
+		{
+			"generated_rsa_labels_update",
+			types.StringValue(algorithmRsa),
+			types.StringValue(algorithmRsa),
+			types.Int64Value(defaultRsaBits),
+			types.Int64Null(),
+			false,
+		},
+		{
+			"algorithm_changed",
+			types.StringValue(algorithmEd25519),
+			types.StringValue(algorithmRsa),
+			types.Int64Null(),
+			types.Int64Null(),
+			true,
+		},
+		{
+			"rsa_bits_changed",
+			types.StringValue(algorithmRsa),
+			types.StringValue(algorithmRsa),
+			types.Int64Value(2048),
+			types.Int64Value(4096),
+			true,
+		},
+	}
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewKeyPairResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	algorithmAttribute, ok := schemaResp.Schema.Attributes["algorithm"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("algorithm is not a string attribute")
+	}
+	rsaBitsAttribute, ok := schemaResp.Schema.Attributes["rsa_bits"].(schema.Int64Attribute)
+	if !ok {
+		t.Fatalf("rsa_bits is not an int64 attribute")
+	}
+	// existing resource which is updated in place
+	raw := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			// computed attributes which aren't configured are planned as unknown on updates
+			algorithmPlan := tt.algorithmConfig
+			if algorithmPlan.IsNull() {
+				algorithmPlan = types.StringUnknown()
+			}
+			algorithmReq := planmodifier.StringRequest{
+				Path:        path.Root("algorithm"),
+				State:       tfsdk.State{Raw: raw},
+				Plan:        tfsdk.Plan{Raw: raw},
+				Config:      tfsdk.Config{Raw: raw},
+				StateValue:  tt.algorithmState,
+				ConfigValue: tt.algorithmConfig,
+				PlanValue:   algorithmPlan,
+			}
+			algorithmResp := &planmodifier.StringResponse{PlanValue: algorithmPlan}
+			for _, modifier := range algorithmAttribute.PlanModifiers {
+				modifier.PlanModifyString(ctx, algorithmReq, algorithmResp)
+				algorithmReq.PlanValue = algorithmResp.PlanValue
+			}
+
+			rsaBitsPlan := tt.rsaBitsConfig
+			if rsaBitsPlan.IsNull() {
+				rsaBitsPlan = types.Int64Unknown()
+			}
+			rsaBitsReq := planmodifier.Int64Request{
+				Path:        path.Root("rsa_bits"),
+				State:       tfsdk.State{Raw: raw},
+				Plan:        tfsdk.Plan{Raw: raw},
+				Config:      tfsdk.Config{Raw: raw},
+				StateValue:  tt.rsaBitsState,
+				ConfigValue: tt.rsaBitsConfig,
+				PlanValue:   rsaBitsPlan,
+			}
+			rsaBitsResp := &planmodifier.Int64Response{PlanValue: rsaBitsPlan}
+			for _, modifier := range rsaBitsAttribute.PlanModifiers {
+				modifier.PlanModifyInt64(ctx, rsaBitsReq, rsaBitsResp)
+				rsaBitsReq.PlanValue = rsaBitsResp.PlanValue
+			}
+
+			if algorithmResp.Diagnostics.HasError() || rsaBitsResp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed")
+			}
+			requiresReplace := algorithmResp.RequiresReplace || rsaBitsResp.RequiresReplace
+			if requiresReplace != tt.requiresReplace {
+				t.Fatalf("Expected requires replace %t, got %t", tt.requiresReplace, requiresReplace)
+			}
+			if !tt.requiresReplace {
+				if !algorithmResp.PlanValue.Equal(tt.algorithmState) {
+					t.Fatalf("Expected algorithm %s, got %s", tt.algorithmState, algorithmResp.PlanValue)
+				}
+				if !rsaBitsResp.PlanValue.Equal(tt.rsaBitsState) {
+					t.Fatalf("Expected rsa_bits %s, got %s", tt.rsaBitsState, rsaBitsResp.PlanValue)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package machinetype
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &machineTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &machineTypesDataSource{}
+)
+
+type DataSourceModel struct {
+	Id           types.String `tfsdk:"id"` // needed by TF
+	ProjectId    types.String `tfsdk:"project_id"`
+	Filter       types.String `tfsdk:"filter"`
+	MachineTypes types.List   `tfsdk:"machine_types"`
+}
+
+// Types corresponding to a single element of DataSourceModel.MachineTypes
+var machineTypeTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"description": types.StringType,
+	"vcpus":       types.Int64Type,
+	"ram":         types.Int64Type,
+	"disk":        types.Int64Type,
+	"extra_specs": types.MapType{ElemType: types.StringType},
+}
+
+// NewMachineTypesDataSource is a helper function to simplify the provider implementation.
+func NewMachineTypesDataSource() datasource.DataSource {
+	return &machineTypesDataSource{}
+}
+
+// machineTypesDataSource is the data source implementation.
+type machineTypesDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *machineTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_types"
+}
+
+func (d *machineTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *machineTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Machine types datasource schema. Lists the machine types (server flavors) available in a project. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal datasource ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID for which the machine types are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "Filter expression which is evaluated by the API, e.g. `vcpus >= 4 && ram >= 16384`. A subset of [expr-lang](https://expr-lang.org/docs/language-definition) is supported.",
+				Description:         "Filter expression which is evaluated by the API, e.g. `vcpus >= 4 && ram >= 16384`. A subset of expr-lang is supported.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"machine_types": schema.ListNestedAttribute{
+				Description: "List of machine types, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the machine type, e.g. `g1.1`.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the machine type.",
+							Computed:    true,
+						},
+						"vcpus": schema.Int64Attribute{
+							Description: "The number of virtual CPUs.",
+							Computed:    true,
+						},
+						"ram": schema.Int64Attribute{
+							Description: "The amount of RAM in MB.",
+							Computed:    true,
+						},
+						"disk": schema.Int64Attribute{
+							Description: "The size of the local disk in GB.",
+							Computed:    true,
+						},
+						"extra_specs": schema.MapAttribute{
+							Description: "Additional hardware properties of the machine type, such as the CPU generation or attached GPUs.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *machineTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	machineTypesReq := d.client.ListMachineTypes(ctx, projectId)
+	if !model.Filter.IsNull() && !model.Filter.IsUnknown() {
+		machineTypesReq = machineTypesReq.Filter(model.Filter.ValueString())
+	}
+	machineTypesResp, err := machineTypesReq.Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading machine types",
+			fmt.Sprintf("Machine types cannot be listed for project %q.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapDataSourceFields(ctx, machineTypesResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading machine types", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Machine types read")
+}
+
+func mapDataSourceFields(ctx context.Context, machineTypesResp *iaas.MachineTypeListResponse, model *DataSourceModel) error {
+	if machineTypesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if machineTypesResp.Items == nil {
+		return fmt.Errorf("items input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString())
+
+	machineTypes := make([]iaas.MachineType, len(*machineTypesResp.Items))
+	copy(machineTypes, *machineTypesResp.Items)
+	// Sort to get a stable result, the API doesn't guarantee any order
+	sort.SliceStable(machineTypes, func(i, j int) bool {
+		return machineTypes[i].GetName() < machineTypes[j].GetName()
+	})
+
+	machineTypesList := []attr.Value{}
+	for i := range machineTypes {
+		machineType := machineTypes[i]
+
+		extraSpecs := map[string]string{}
+		for key, value := range machineType.GetExtraSpecs() {
+			extraSpecs[key] = fmt.Sprintf("%v", value)
+		}
+		extraSpecsTF, diags := types.MapValueFrom(ctx, types.StringType, extraSpecs)
+		if diags.HasError() {
+			return fmt.Errorf("mapping extra specs of index %d: %w", i, core.DiagsToError(diags))
+		}
+
+		machineTypeTF, diags := types.ObjectValue(machineTypeTypes, map[string]attr.Value{
+			"name":        types.StringPointerValue(machineType.Name),
+			"description": types.StringPointerValue(machineType.Description),
+			"vcpus":       types.Int64PointerValue(machineType.Vcpus),
+			"ram":         types.Int64PointerValue(machineType.Ram),
+			"disk":        types.Int64PointerValue(machineType.Disk),
+			"extra_specs": extraSpecsTF,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		machineTypesList = append(machineTypesList, machineTypeTF)
+	}
+
+	machineTypesTF, diags := types.ListValue(types.ObjectType{AttrTypes: machineTypeTypes}, machineTypesList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.MachineTypes = machineTypesTF
+	return nil
+}
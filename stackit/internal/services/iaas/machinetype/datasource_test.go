@@ -0,0 +1,124 @@
+package machinetype
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.MachineTypeListResponse
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+			},
+			&iaas.MachineTypeListResponse{
+				Items: &[]iaas.MachineType{},
+			},
+			DataSourceModel{
+				Id:           types.StringValue("pid"),
+				ProjectId:    types.StringValue("pid"),
+				MachineTypes: types.ListValueMust(types.ObjectType{AttrTypes: machineTypeTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"simple_values_sorted",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				Filter:    types.StringValue("vcpus >= 2"),
+			},
+			&iaas.MachineTypeListResponse{
+				Items: &[]iaas.MachineType{
+					{
+						Name:  utils.Ptr("n1.14d.g1"),
+						Vcpus: utils.Ptr(int64(14)),
+						Ram:   utils.Ptr(int64(65536)),
+						Disk:  utils.Ptr(int64(20)),
+						ExtraSpecs: &map[string]interface{}{
+							"gpu":   "nvidia-a100",
+							"count": 1,
+						},
+					},
+					{
+						Name:        utils.Ptr("g1.2"),
+						Description: utils.Ptr("general purpose"),
+						Vcpus:       utils.Ptr(int64(2)),
+						Ram:         utils.Ptr(int64(8192)),
+						Disk:        utils.Ptr(int64(20)),
+					},
+				},
+			},
+			DataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Filter:    types.StringValue("vcpus >= 2"),
+				MachineTypes: types.ListValueMust(types.ObjectType{AttrTypes: machineTypeTypes}, []attr.Value{
+					types.ObjectValueMust(machineTypeTypes, map[string]attr.Value{
+						"name":        types.StringValue("g1.2"),
+						"description": types.StringValue("general purpose"),
+						"vcpus":       types.Int64Value(2),
+						"ram":         types.Int64Value(8192),
+						"disk":        types.Int64Value(20),
+						"extra_specs": types.MapValueMust(types.StringType, map[string]attr.Value{}),
+					}),
+					types.ObjectValueMust(machineTypeTypes, map[string]attr.Value{
+						"name":        types.StringValue("n1.14d.g1"),
+						"description": types.StringNull(),
+						"vcpus":       types.Int64Value(14),
+						"ram":         types.Int64Value(65536),
+						"disk":        types.Int64Value(20),
+						"extra_specs": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"gpu":   types.StringValue("nvidia-a100"),
+							"count": types.StringValue("1"),
+						}),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			DataSourceModel{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+		{
+			"items_nil_fail",
+			DataSourceModel{},
+			&iaas.MachineTypeListResponse{},
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
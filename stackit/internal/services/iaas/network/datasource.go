@@ -11,9 +11,11 @@ import (
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
 	iaasAlphaUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -24,7 +26,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &networkDataSource{}
+	_ datasource.DataSource                     = &networkDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &networkDataSource{}
 )
 
 // NewNetworkDataSource is a helper function to simplify the provider implementation.
@@ -46,6 +49,25 @@ func (d *networkDataSource) Metadata(_ context.Context, req datasource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_network"
 }
 
+// ConfigValidators validates the data source configuration
+func (d *networkDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("network_id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("labels"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("network_id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("network_id"),
+			path.MatchRoot("labels"),
+		),
+	}
+}
+
 func (d *networkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	var ok bool
 	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -77,7 +99,7 @@ func (d *networkDataSource) Configure(ctx context.Context, req datasource.Config
 // Schema defines the schema for the data source.
 func (d *networkDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Network resource schema. Must have a `region` specified in the provider configuration.",
+		Description: "Network resource schema. The network is looked up either by `network_id` or by `name` and/or `labels`, which must match exactly one network. Must have a `region` specified in the provider configuration.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`network_id`\".",
@@ -92,15 +114,17 @@ func (d *networkDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				},
 			},
 			"network_id": schema.StringAttribute{
-				Description: "The network ID.",
-				Required:    true,
+				Description: "The network ID. Conflicts with `name` and `labels`.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
 					validate.UUID(),
 					validate.NoSeparator(),
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the network.",
+				Description: "The name of the network. If provided, the network is looked up by its name.",
+				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
@@ -170,8 +194,9 @@ func (d *networkDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed:    true,
 			},
 			"labels": schema.MapAttribute{
-				Description: "Labels are key-value string pairs which can be attached to a resource container",
+				Description: "Labels are key-value string pairs which can be attached to a resource container. If provided, the network is looked up by these labels, the network may have further labels.",
 				ElementType: types.StringType,
+				Optional:    true,
 				Computed:    true,
 			},
 			"routed": schema.BoolAttribute{
@@ -28,20 +28,55 @@ func DatasourceRead(ctx context.Context, req datasource.ReadRequest, resp *datas
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "network_id", networkId)
 
-	networkResp, err := client.GetNetwork(ctx, projectId, networkId).Execute()
-	if err != nil {
-		utils.LogError(
-			ctx,
-			&resp.Diagnostics,
-			err,
-			"Reading network",
-			fmt.Sprintf("Network with ID %q does not exist in project %q.", networkId, projectId),
-			map[int]string{
-				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
-			},
-		)
-		resp.State.RemoveResource(ctx)
-		return
+	var networkResp *iaas.Network
+	var err error
+	if networkId != "" {
+		networkResp, err = client.GetNetwork(ctx, projectId, networkId).Execute()
+		if err != nil {
+			utils.LogError(
+				ctx,
+				&resp.Diagnostics,
+				err,
+				"Reading network",
+				fmt.Sprintf("Network with ID %q does not exist in project %q.", networkId, projectId),
+				map[int]string{
+					http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+				},
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	} else {
+		labelSelector, err := iaasUtils.LabelSelector(ctx, model.Labels)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network", fmt.Sprintf("Building label selector: %v", err))
+			return
+		}
+		networksReq := client.ListNetworks(ctx, projectId)
+		if labelSelector != "" {
+			networksReq = networksReq.LabelSelector(labelSelector)
+		}
+		networksResp, err := networksReq.Execute()
+		if err != nil {
+			utils.LogError(
+				ctx,
+				&resp.Diagnostics,
+				err,
+				"Reading network",
+				fmt.Sprintf("Networks cannot be listed for project %q.", projectId),
+				map[int]string{
+					http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+				},
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		networkResp, err = findNetwork(networksResp, model.Name.ValueString())
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network", fmt.Sprintf("Looking up network in project %q: %v", projectId, err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 	}
 
 	err = mapDataSourceFields(ctx, networkResp, &model)
@@ -57,6 +92,29 @@ func DatasourceRead(ctx context.Context, req datasource.ReadRequest, resp *datas
 	tflog.Info(ctx, "Network read")
 }
 
+// findNetwork returns the only network of the list response, optionally filtered by name
+func findNetwork(networksResp *iaas.NetworkListResponse, name string) (*iaas.Network, error) {
+	if networksResp == nil {
+		return nil, fmt.Errorf("response input is nil")
+	}
+
+	var found *iaas.Network
+	for i := range networksResp.GetItems() {
+		network := &(*networksResp.Items)[i]
+		if name != "" && network.GetName() != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("multiple networks match the name %q and labels, use `network_id` instead", name)
+		}
+		found = network
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no network matches the name %q and labels", name)
+	}
+	return found, nil
+}
+
 func mapDataSourceFields(ctx context.Context, networkResp *iaas.Network, model *networkModel.DataSourceModel) error {
 	if networkResp == nil {
 		return fmt.Errorf("response input is nil")
@@ -350,3 +350,84 @@ func TestMapDataSourceFields(t *testing.T) {
 		})
 	}
 }
+
+func TestFindNetwork(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.NetworkListResponse
+		name        string
+		expected    *iaas.Network
+		isValid     bool
+	}{
+		{
+			"by_name",
+			&iaas.NetworkListResponse{
+				Items: &[]iaas.Network{
+					{NetworkId: utils.Ptr("nid-1"), Name: utils.Ptr("shared")},
+					{NetworkId: utils.Ptr("nid-2"), Name: utils.Ptr("private")},
+				},
+			},
+			"private",
+			&iaas.Network{NetworkId: utils.Ptr("nid-2"), Name: utils.Ptr("private")},
+			true,
+		},
+		{
+			"only_labels",
+			&iaas.NetworkListResponse{
+				Items: &[]iaas.Network{
+					{NetworkId: utils.Ptr("nid-1"), Name: utils.Ptr("shared")},
+				},
+			},
+			"",
+			&iaas.Network{NetworkId: utils.Ptr("nid-1"), Name: utils.Ptr("shared")},
+			true,
+		},
+		{
+			"not_unique",
+			&iaas.NetworkListResponse{
+				Items: &[]iaas.Network{
+					{NetworkId: utils.Ptr("nid-1"), Name: utils.Ptr("shared")},
+					{NetworkId: utils.Ptr("nid-2"), Name: utils.Ptr("private")},
+				},
+			},
+			"",
+			nil,
+			false,
+		},
+		{
+			"not_found",
+			&iaas.NetworkListResponse{
+				Items: &[]iaas.Network{
+					{NetworkId: utils.Ptr("nid-1"), Name: utils.Ptr("shared")},
+				},
+			},
+			"private",
+			nil,
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			"private",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := findNetwork(tt.input, tt.name)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
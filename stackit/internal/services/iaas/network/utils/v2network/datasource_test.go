@@ -385,3 +385,84 @@ func TestMapDataSourceFields(t *testing.T) {
 		})
 	}
 }
+
+func TestFindNetwork(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaasalpha.NetworkListResponse
+		name        string
+		expected    *iaasalpha.Network
+		isValid     bool
+	}{
+		{
+			"by_name",
+			&iaasalpha.NetworkListResponse{
+				Items: &[]iaasalpha.Network{
+					{Id: utils.Ptr("nid-1"), Name: utils.Ptr("shared")},
+					{Id: utils.Ptr("nid-2"), Name: utils.Ptr("private")},
+				},
+			},
+			"private",
+			&iaasalpha.Network{Id: utils.Ptr("nid-2"), Name: utils.Ptr("private")},
+			true,
+		},
+		{
+			"only_labels",
+			&iaasalpha.NetworkListResponse{
+				Items: &[]iaasalpha.Network{
+					{Id: utils.Ptr("nid-1"), Name: utils.Ptr("shared")},
+				},
+			},
+			"",
+			&iaasalpha.Network{Id: utils.Ptr("nid-1"), Name: utils.Ptr("shared")},
+			true,
+		},
+		{
+			"not_unique",
+			&iaasalpha.NetworkListResponse{
+				Items: &[]iaasalpha.Network{
+					{Id: utils.Ptr("nid-1"), Name: utils.Ptr("shared")},
+					{Id: utils.Ptr("nid-2"), Name: utils.Ptr("private")},
+				},
+			},
+			"",
+			nil,
+			false,
+		},
+		{
+			"not_found",
+			&iaasalpha.NetworkListResponse{
+				Items: &[]iaasalpha.Network{
+					{Id: utils.Ptr("nid-1"), Name: utils.Ptr("shared")},
+				},
+			},
+			"private",
+			nil,
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			"private",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := findNetwork(tt.input, tt.name)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package networkareaprojects
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &networkAreaProjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &networkAreaProjectsDataSource{}
+)
+
+type DataSourceModel struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	OrganizationId types.String `tfsdk:"organization_id"`
+	NetworkAreaId  types.String `tfsdk:"network_area_id"`
+	ProjectIds     types.List   `tfsdk:"project_ids"`
+}
+
+// NewNetworkAreaProjectsDataSource is a helper function to simplify the provider implementation.
+func NewNetworkAreaProjectsDataSource() datasource.DataSource {
+	return &networkAreaProjectsDataSource{}
+}
+
+// networkAreaProjectsDataSource is the data source implementation.
+type networkAreaProjectsDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *networkAreaProjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_area_projects"
+}
+
+func (d *networkAreaProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "IaaS client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *networkAreaProjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Network area projects datasource schema. Lists the projects which are attached to a network area. " +
+		"A project is attached to a network area on creation by setting the label `networkArea=<networkAreaID>` in the `stackit_resourcemanager_project` resource. " +
+		"Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal datasource ID. It is structured as \"`organization_id`,`network_area_id`\".",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "STACKIT organization ID to which the network area is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"network_area_id": schema.StringAttribute{
+				Description: "The network area ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"project_ids": schema.ListAttribute{
+				Description: "The IDs of the projects attached to the network area, sorted in ascending order.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *networkAreaProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	projectsResp, err := d.client.ListNetworkAreaProjects(ctx, organizationId, networkAreaId).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading network area projects",
+			fmt.Sprintf("Network area with ID %q does not exist in organization %q.", networkAreaId, organizationId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Organization with ID %q not found or forbidden access", organizationId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapDataSourceFields(ctx, projectsResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area projects", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Network area projects read")
+}
+
+func mapDataSourceFields(ctx context.Context, projectsResp *iaas.ProjectListResponse, model *DataSourceModel) error {
+	if projectsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.OrganizationId.ValueString(), model.NetworkAreaId.ValueString())
+
+	projectIds := make([]string, len(projectsResp.GetItems()))
+	copy(projectIds, projectsResp.GetItems())
+	// Sort to get a stable result, the API doesn't guarantee any order
+	sort.Strings(projectIds)
+
+	projectIdsTF, diags := types.ListValueFrom(ctx, types.StringType, projectIds)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.ProjectIds = projectIdsTF
+	return nil
+}
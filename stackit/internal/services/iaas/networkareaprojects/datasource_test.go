@@ -0,0 +1,81 @@
+package networkareaprojects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.ProjectListResponse
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			DataSourceModel{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+			},
+			&iaas.ProjectListResponse{},
+			DataSourceModel{
+				Id:             types.StringValue("oid,naid"),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				ProjectIds:     types.ListValueMust(types.StringType, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"simple_values_sorted",
+			DataSourceModel{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+			},
+			&iaas.ProjectListResponse{
+				Items: &[]string{"pid-2", "pid-1"},
+			},
+			DataSourceModel{
+				Id:             types.StringValue("oid,naid"),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				ProjectIds: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("pid-1"),
+					types.StringValue("pid-2"),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			DataSourceModel{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package networkarearoutes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &networkAreaRoutesDataSource{}
+	_ datasource.DataSourceWithConfigure = &networkAreaRoutesDataSource{}
+)
+
+type DataSourceModel struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	OrganizationId types.String `tfsdk:"organization_id"`
+	NetworkAreaId  types.String `tfsdk:"network_area_id"`
+	Labels         types.Map    `tfsdk:"labels"`
+	Routes         types.List   `tfsdk:"routes"`
+}
+
+// Types corresponding to a single element of DataSourceModel.Routes
+var dataSourceRouteTypes = map[string]attr.Type{
+	"network_area_route_id": types.StringType,
+	"prefix":                types.StringType,
+	"next_hop":              types.StringType,
+	"labels":                types.MapType{ElemType: types.StringType},
+}
+
+// NewNetworkAreaRoutesDataSource is a helper function to simplify the provider implementation.
+func NewNetworkAreaRoutesDataSource() datasource.DataSource {
+	return &networkAreaRoutesDataSource{}
+}
+
+// networkAreaRoutesDataSource is the data source implementation.
+type networkAreaRoutesDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *networkAreaRoutesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_area_routes"
+}
+
+func (d *networkAreaRoutesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "IaaS client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *networkAreaRoutesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Network area routes datasource schema. Lists the static routes of a network area. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`organization_id`,`network_area_id`\".",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "STACKIT organization ID to which the network area is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"network_area_id": schema.StringAttribute{
+				Description: "The network area ID to which the network area routes are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Only routes which have all of these labels are listed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"routes": schema.ListNestedAttribute{
+				Description: "List of network area routes, sorted by prefix.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"network_area_route_id": schema.StringAttribute{
+							Description: "The network area route ID.",
+							Computed:    true,
+						},
+						"prefix": schema.StringAttribute{
+							Description: "The network, that is reachable though the Next Hop. Should use CIDR notation.",
+							Computed:    true,
+						},
+						"next_hop": schema.StringAttribute{
+							Description: "The IP address of the routing system, that will route the prefix configured. Should be a valid IPv4 address.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *networkAreaRoutesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	labelSelector, err := iaasUtils.LabelSelector(ctx, model.Labels)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area routes", fmt.Sprintf("Building label selector: %v", err))
+		return
+	}
+	routesReq := d.client.ListNetworkAreaRoutes(ctx, organizationId, networkAreaId)
+	if labelSelector != "" {
+		routesReq = routesReq.LabelSelector(labelSelector)
+	}
+	routesResp, err := routesReq.Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading network area routes",
+			fmt.Sprintf("Network area with ID %q does not exist in organization %q.", networkAreaId, organizationId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Organization with ID %q not found or forbidden access", organizationId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapDataSourceFields(ctx, routesResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area routes", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Network area routes read")
+}
+
+func mapDataSourceFields(ctx context.Context, routesResp *iaas.RouteListResponse, model *DataSourceModel) error {
+	if routesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.OrganizationId.ValueString(), model.NetworkAreaId.ValueString())
+
+	routes := make([]iaas.Route, len(routesResp.GetItems()))
+	copy(routes, routesResp.GetItems())
+	// Sort to get a stable result, the API doesn't guarantee any order
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].GetPrefix() != routes[j].GetPrefix() {
+			return routes[i].GetPrefix() < routes[j].GetPrefix()
+		}
+		return routes[i].GetRouteId() < routes[j].GetRouteId()
+	})
+
+	routesList := []attr.Value{}
+	for i := range routes {
+		networkAreaRoute := routes[i]
+
+		labels, err := iaasUtils.MapLabels(ctx, networkAreaRoute.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return fmt.Errorf("mapping labels of index %d: %w", i, err)
+		}
+
+		routeTF, diags := types.ObjectValue(dataSourceRouteTypes, map[string]attr.Value{
+			"network_area_route_id": types.StringPointerValue(networkAreaRoute.RouteId),
+			"prefix":                types.StringPointerValue(networkAreaRoute.Prefix),
+			"next_hop":              types.StringPointerValue(networkAreaRoute.Nexthop),
+			"labels":                labels,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		routesList = append(routesList, routeTF)
+	}
+
+	routesTF, diags := types.ListValue(types.ObjectType{AttrTypes: dataSourceRouteTypes}, routesList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Routes = routesTF
+	return nil
+}
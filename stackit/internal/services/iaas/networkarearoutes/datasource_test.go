@@ -0,0 +1,112 @@
+package networkarearoutes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.RouteListResponse
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			DataSourceModel{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Labels:         types.MapNull(types.StringType),
+			},
+			&iaas.RouteListResponse{},
+			DataSourceModel{
+				Id:             types.StringValue("oid,naid"),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Labels:         types.MapNull(types.StringType),
+				Routes:         types.ListValueMust(types.ObjectType{AttrTypes: dataSourceRouteTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"simple_values_sorted",
+			DataSourceModel{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Labels:         types.MapNull(types.StringType),
+			},
+			&iaas.RouteListResponse{
+				Items: &[]iaas.Route{
+					{
+						RouteId: utils.Ptr("rid-2"),
+						Prefix:  utils.Ptr("10.1.0.0/24"),
+						Nexthop: utils.Ptr("192.168.0.2"),
+						Labels: &map[string]interface{}{
+							"key": "value",
+						},
+					},
+					{
+						RouteId: utils.Ptr("rid-1"),
+						Prefix:  utils.Ptr("10.0.0.0/24"),
+						Nexthop: utils.Ptr("192.168.0.1"),
+					},
+				},
+			},
+			DataSourceModel{
+				Id:             types.StringValue("oid,naid"),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Labels:         types.MapNull(types.StringType),
+				Routes: types.ListValueMust(types.ObjectType{AttrTypes: dataSourceRouteTypes}, []attr.Value{
+					types.ObjectValueMust(dataSourceRouteTypes, map[string]attr.Value{
+						"network_area_route_id": types.StringValue("rid-1"),
+						"prefix":                types.StringValue("10.0.0.0/24"),
+						"next_hop":              types.StringValue("192.168.0.1"),
+						"labels":                types.MapNull(types.StringType),
+					}),
+					types.ObjectValueMust(dataSourceRouteTypes, map[string]attr.Value{
+						"network_area_route_id": types.StringValue("rid-2"),
+						"prefix":                types.StringValue("10.1.0.0/24"),
+						"next_hop":              types.StringValue("192.168.0.2"),
+						"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"key": types.StringValue("value"),
+						}),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			DataSourceModel{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
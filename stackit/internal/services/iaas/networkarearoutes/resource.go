@@ -0,0 +1,647 @@
+package networkarearoutes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &networkAreaRoutesResource{}
+	_ resource.ResourceWithConfigure   = &networkAreaRoutesResource{}
+	_ resource.ResourceWithImportState = &networkAreaRoutesResource{}
+)
+
+type Model struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	OrganizationId types.String `tfsdk:"organization_id"`
+	NetworkAreaId  types.String `tfsdk:"network_area_id"`
+	Routes         types.Set    `tfsdk:"routes"`
+}
+
+// Struct corresponding to a single element of Model.Routes
+type routeModel struct {
+	Prefix  types.String `tfsdk:"prefix"`
+	NextHop types.String `tfsdk:"next_hop"`
+	Labels  types.Map    `tfsdk:"labels"`
+}
+
+// Types corresponding to routeModel
+var routeTypes = map[string]attr.Type{
+	"prefix":   types.StringType,
+	"next_hop": types.StringType,
+	"labels":   types.MapType{ElemType: types.StringType},
+}
+
+// NewNetworkAreaRoutesResource is a helper function to simplify the provider implementation.
+func NewNetworkAreaRoutesResource() resource.Resource {
+	return &networkAreaRoutesResource{}
+}
+
+// networkAreaRoutesResource is the resource implementation.
+type networkAreaRoutesResource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *networkAreaRoutesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_area_routes"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *networkAreaRoutesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "IaaS client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *networkAreaRoutesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Network area routes resource schema. Manages the complete set of static routes of a network area: " +
+		"routes which are not part of the configuration, including routes created outside of Terraform, are deleted. " +
+		"Must not be combined with `stackit_network_area_route` resources for the same network area. " +
+		"The network area routes only support IPv4 next hops, blackhole and internet next hops are supported by the routes of routing tables. " +
+		"Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`organization_id`,`network_area_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "STACKIT organization ID to which the network area is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"network_area_id": schema.StringAttribute{
+				Description: "The network area ID to which the network area routes are associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"routes": schema.SetNestedAttribute{
+				Description: "The static routes of the network area. A route with a changed `prefix` or `next_hop` is deleted and created again, changed labels are updated in place.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"prefix": schema.StringAttribute{
+							Description: "The network, that is reachable though the Next Hop. Should use CIDR notation.",
+							Required:    true,
+							Validators: []validator.String{
+								validate.CIDR(),
+							},
+						},
+						"next_hop": schema.StringAttribute{
+							Description: "The IP address of the routing system, that will route the prefix configured. Should be a valid IPv4 address.",
+							Required:    true,
+							Validators: []validator.String{
+								validate.IPv4(false),
+							},
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *networkAreaRoutesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	err := r.reconcile(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area routes", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Network area routes created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *networkAreaRoutesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	routesResp, err := r.client.ListNetworkAreaRoutesExecute(ctx, organizationId, networkAreaId)
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area routes", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(ctx, routesResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area routes", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Network area routes read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *networkAreaRoutesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	err := r.reconcile(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area routes", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Network area routes updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// Only the routes which are part of the state are deleted.
+func (r *networkAreaRoutesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	routes, err := toRoutes(ctx, model.Routes)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area routes", fmt.Sprintf("Processing state: %v", err))
+		return
+	}
+	routesResp, err := r.client.ListNetworkAreaRoutesExecute(ctx, organizationId, networkAreaId)
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Network area already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area routes", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Routes of the state which still exist are deleted, other routes are kept
+	matched, _, _ := matchRoutes(routes, routesResp.GetItems())
+	for i := range matched {
+		err := r.deleteRoute(ctx, organizationId, networkAreaId, matched[i].actual.GetRouteId())
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area routes", err.Error())
+			return
+		}
+	}
+	tflog.Info(ctx, "Network area routes deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: organization_id,network_area_id
+func (r *networkAreaRoutesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing network area routes",
+			fmt.Sprintf("Expected import identifier with format: [organization_id],[network_area_id]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	organizationId := idParts[0]
+	networkAreaId := idParts[1]
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_area_id"), networkAreaId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("routes"), types.SetNull(types.ObjectType{AttrTypes: routeTypes}))...)
+	tflog.Info(ctx, "Network area routes state imported")
+}
+
+// reconcile creates the routes of the model which don't exist yet, updates the labels of existing routes
+// and deletes all other routes of the network area.
+// Routes are created before the obsolete routes are deleted, so traffic keeps being routed during the update.
+// Only routes with the prefix of a missing route, e.g. after a change of the next hop, are deleted first,
+// as the prefix can't be routed to two next hops. Their prefix is not routed until the new route is created.
+func (r *networkAreaRoutesResource) reconcile(ctx context.Context, model *Model) error {
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+
+	routes, err := toRoutes(ctx, model.Routes)
+	if err != nil {
+		return fmt.Errorf("processing configuration: %w", err)
+	}
+	routesResp, err := r.client.ListNetworkAreaRoutesExecute(ctx, organizationId, networkAreaId)
+	if err != nil {
+		return fmt.Errorf("listing routes: %w", err)
+	}
+
+	matched, missing, unmatched := matchRoutes(routes, routesResp.GetItems())
+	conflicting, obsolete := splitConflictingRoutes(missing, unmatched)
+	for i := range conflicting {
+		tflog.Info(ctx, "Deleting network area route which conflicts with a configured route", map[string]any{"network_area_route_id": conflicting[i].GetRouteId()})
+		err := r.deleteRoute(ctx, organizationId, networkAreaId, conflicting[i].GetRouteId())
+		if err != nil {
+			return err
+		}
+	}
+	for i := range matched {
+		if labelsEqual(matched[i].configured.labels, matched[i].actual.Labels) {
+			continue
+		}
+		payload, err := toUpdatePayload(ctx, matched[i].configured, matched[i].actual)
+		if err != nil {
+			return fmt.Errorf("creating API payload: %w", err)
+		}
+		routeId := matched[i].actual.GetRouteId()
+		_, err = r.client.UpdateNetworkAreaRoute(ctx, organizationId, networkAreaId, routeId).UpdateNetworkAreaRoutePayload(*payload).Execute()
+		if err != nil {
+			return fmt.Errorf("updating route %q: %w", routeId, err)
+		}
+		tflog.Info(ctx, "Network area route updated", map[string]any{"network_area_route_id": routeId})
+	}
+	if len(missing) > 0 {
+		payload := toCreatePayload(missing)
+		created, err := r.client.CreateNetworkAreaRoute(ctx, organizationId, networkAreaId).CreateNetworkAreaRoutePayload(*payload).Execute()
+		if err != nil {
+			return fmt.Errorf("creating routes: %w", err)
+		}
+		tflog.Info(ctx, "Network area routes created", map[string]any{"count": len(created.GetItems())})
+	}
+	for i := range obsolete {
+		tflog.Info(ctx, "Deleting network area route which is not part of the configuration", map[string]any{"network_area_route_id": obsolete[i].GetRouteId()})
+		err := r.deleteRoute(ctx, organizationId, networkAreaId, obsolete[i].GetRouteId())
+		if err != nil {
+			return err
+		}
+	}
+
+	model.Id = utils.BuildInternalTerraformId(organizationId, networkAreaId)
+	return nil
+}
+
+func (r *networkAreaRoutesResource) deleteRoute(ctx context.Context, organizationId, networkAreaId, routeId string) error {
+	err := r.client.DeleteNetworkAreaRoute(ctx, organizationId, networkAreaId, routeId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("deleting route %q: %w", routeId, err)
+	}
+	return nil
+}
+
+// mapFields maps the routes of the network area to the model. Routes which match a route of the current state keep
+// the prefix and next hop notation of the state, all other routes, e.g. created outside of Terraform, are added as returned by the API.
+func mapFields(ctx context.Context, routesResp *iaas.RouteListResponse, model *Model) error {
+	if routesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.OrganizationId.ValueString(), model.NetworkAreaId.ValueString())
+
+	stateRoutes := []routeModel{}
+	if !model.Routes.IsNull() && !model.Routes.IsUnknown() {
+		diags := model.Routes.ElementsAs(ctx, &stateRoutes, false)
+		if diags.HasError() {
+			return fmt.Errorf("processing state: %w", core.DiagsToError(diags))
+		}
+	}
+
+	routesList := []attr.Value{}
+	remaining := routesResp.GetItems()
+	for i := range stateRoutes {
+		stateRoute := stateRoutes[i]
+		r := &route{
+			prefix:  stateRoute.Prefix.ValueString(),
+			nextHop: stateRoute.NextHop.ValueString(),
+		}
+		index := slices.IndexFunc(remaining, func(actual iaas.Route) bool {
+			return r.matches(&actual)
+		})
+		if index < 0 {
+			// the route was deleted outside of Terraform
+			continue
+		}
+		routeTF, err := toRouteValue(ctx, &remaining[index], stateRoute)
+		if err != nil {
+			return fmt.Errorf("mapping route %q: %w", remaining[index].GetRouteId(), err)
+		}
+		remaining = slices.Delete(slices.Clone(remaining), index, index+1)
+		routesList = append(routesList, routeTF)
+	}
+	for i := range remaining {
+		routeTF, err := toRouteValue(ctx, &remaining[i], routeModel{
+			Prefix:  types.StringPointerValue(remaining[i].Prefix),
+			NextHop: types.StringPointerValue(remaining[i].Nexthop),
+			Labels:  types.MapNull(types.StringType),
+		})
+		if err != nil {
+			return fmt.Errorf("mapping route %q: %w", remaining[i].GetRouteId(), err)
+		}
+		routesList = append(routesList, routeTF)
+	}
+
+	routesTF, diags := types.SetValue(types.ObjectType{AttrTypes: routeTypes}, routesList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Routes = routesTF
+	return nil
+}
+
+// toRouteValue maps a route returned by the API to an element of Model.Routes, the prefix and next hop are taken from current
+func toRouteValue(ctx context.Context, networkAreaRoute *iaas.Route, current routeModel) (attr.Value, error) {
+	labels, err := iaasUtils.MapLabels(ctx, networkAreaRoute.Labels, current.Labels)
+	if err != nil {
+		return nil, err
+	}
+	routeTF, diags := types.ObjectValue(routeTypes, map[string]attr.Value{
+		"prefix":   current.Prefix,
+		"next_hop": current.NextHop,
+		"labels":   labels,
+	})
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+	return routeTF, nil
+}
+
+// route is the configuration of a single route
+type route struct {
+	prefix  string
+	nextHop string
+	labels  map[string]interface{}
+}
+
+// matchedRoute is a configured route together with the existing route it matches
+type matchedRoute struct {
+	configured *route
+	actual     *iaas.Route
+}
+
+// matches reports whether the route returned by the API has the prefix and next hop of the configured route
+func (r *route) matches(actual *iaas.Route) bool {
+	if actual == nil {
+		return false
+	}
+	return samePrefix(r.prefix, actual.GetPrefix()) && sameAddress(r.nextHop, actual.GetNexthop())
+}
+
+// samePrefix compares two prefixes in CIDR notation, falling back to a string comparison if they can't be parsed
+func samePrefix(a, b string) bool {
+	prefixA, errA := netip.ParsePrefix(a)
+	prefixB, errB := netip.ParsePrefix(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return prefixA.Masked() == prefixB.Masked()
+}
+
+// sameAddress compares two IP addresses, falling back to a string comparison if they can't be parsed
+func sameAddress(a, b string) bool {
+	addrA, errA := netip.ParseAddr(a)
+	addrB, errB := netip.ParseAddr(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return addrA == addrB
+}
+
+// labelsEqual reports whether the labels of an existing route are equal to the configured labels
+func labelsEqual(configured map[string]interface{}, actual *map[string]interface{}) bool { //nolint:gocritic // the API returns a pointer to the map
+	actualLabels := map[string]interface{}{}
+	if actual != nil {
+		actualLabels = *actual
+	}
+	if len(configured) != len(actualLabels) {
+		return false
+	}
+	for key, value := range configured {
+		actualValue, ok := actualLabels[key]
+		if !ok || fmt.Sprint(actualValue) != fmt.Sprint(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchRoutes pairs the configured routes with the existing routes by prefix and next hop.
+// It returns the matched routes, the configured routes which don't exist and the existing routes which aren't configured.
+func matchRoutes(routes []*route, actual []iaas.Route) (matched []matchedRoute, missing []*route, unmatched []iaas.Route) {
+	used := make([]bool, len(actual))
+	for _, r := range routes {
+		found := false
+		for i := range actual {
+			if !used[i] && r.matches(&actual[i]) {
+				used[i] = true
+				found = true
+				matched = append(matched, matchedRoute{configured: r, actual: &actual[i]})
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, r)
+		}
+	}
+	for i := range actual {
+		if !used[i] {
+			unmatched = append(unmatched, actual[i])
+		}
+	}
+	return matched, missing, unmatched
+}
+
+// splitConflictingRoutes splits the unmatched routes into the routes which have the prefix of a missing route
+// and have to be deleted before the missing routes are created, and all other routes
+func splitConflictingRoutes(missing []*route, unmatched []iaas.Route) (conflicting, obsolete []iaas.Route) {
+	for i := range unmatched {
+		isConflicting := false
+		for _, r := range missing {
+			if samePrefix(r.prefix, unmatched[i].GetPrefix()) {
+				isConflicting = true
+				break
+			}
+		}
+		if isConflicting {
+			conflicting = append(conflicting, unmatched[i])
+		} else {
+			obsolete = append(obsolete, unmatched[i])
+		}
+	}
+	return conflicting, obsolete
+}
+
+func toRoutes(ctx context.Context, routesTF types.Set) ([]*route, error) {
+	if routesTF.IsNull() || routesTF.IsUnknown() {
+		return nil, nil
+	}
+	routeModels := []routeModel{}
+	diags := routesTF.ElementsAs(ctx, &routeModels, false)
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	routes := []*route{}
+	for i := range routeModels {
+		routeModel := routeModels[i]
+		labels := map[string]interface{}{}
+		if !(routeModel.Labels.IsNull() || routeModel.Labels.IsUnknown()) {
+			var err error
+			labels, err = conversion.ToStringInterfaceMap(ctx, routeModel.Labels)
+			if err != nil {
+				return nil, fmt.Errorf("converting labels: %w", err)
+			}
+		}
+		routes = append(routes, &route{
+			prefix:  routeModel.Prefix.ValueString(),
+			nextHop: routeModel.NextHop.ValueString(),
+			labels:  labels,
+		})
+	}
+	return routes, nil
+}
+
+func toCreatePayload(routes []*route) *iaas.CreateNetworkAreaRoutePayload {
+	ipv4 := make([]iaas.Route, 0, len(routes))
+	for _, r := range routes {
+		labels := r.labels
+		ipv4 = append(ipv4, iaas.Route{
+			Prefix:  sdkUtils.Ptr(r.prefix),
+			Nexthop: sdkUtils.Ptr(r.nextHop),
+			Labels:  &labels,
+		})
+	}
+	return &iaas.CreateNetworkAreaRoutePayload{
+		Ipv4: &ipv4,
+	}
+}
+
+func toUpdatePayload(ctx context.Context, configured *route, actual *iaas.Route) (*iaas.UpdateNetworkAreaRoutePayload, error) {
+	if configured == nil || actual == nil {
+		return nil, fmt.Errorf("nil route")
+	}
+
+	currentLabels, err := iaasUtils.MapLabels(ctx, actual.Labels, types.MapNull(types.StringType))
+	if err != nil {
+		return nil, fmt.Errorf("converting current labels: %w", err)
+	}
+	desiredLabels, err := iaasUtils.MapLabels(ctx, &configured.labels, types.MapNull(types.StringType))
+	if err != nil {
+		return nil, fmt.Errorf("converting desired labels: %w", err)
+	}
+	labels, err := conversion.ToJSONMapPartialUpdatePayload(ctx, currentLabels, desiredLabels)
+	if err != nil {
+		return nil, fmt.Errorf("converting to Go map: %w", err)
+	}
+
+	return &iaas.UpdateNetworkAreaRoutePayload{
+		Labels: &labels,
+	}, nil
+}
@@ -0,0 +1,420 @@
+package networkarearoutes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       Model
+		input       *iaas.RouteListResponse
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			Model{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Routes:         types.SetNull(types.ObjectType{AttrTypes: routeTypes}),
+			},
+			&iaas.RouteListResponse{},
+			Model{
+				Id:             types.StringValue("oid,naid"),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Routes:         types.SetValueMust(types.ObjectType{AttrTypes: routeTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"state_notation_kept_and_unmanaged_route_added",
+			Model{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Routes: types.SetValueMust(types.ObjectType{AttrTypes: routeTypes}, []attr.Value{
+					types.ObjectValueMust(routeTypes, map[string]attr.Value{
+						"prefix":   types.StringValue("10.0.0.1/24"),
+						"next_hop": types.StringValue("192.168.0.1"),
+						"labels":   types.MapNull(types.StringType),
+					}),
+					types.ObjectValueMust(routeTypes, map[string]attr.Value{
+						"prefix":   types.StringValue("10.2.0.0/24"),
+						"next_hop": types.StringValue("192.168.0.1"),
+						"labels":   types.MapNull(types.StringType),
+					}),
+				}),
+			},
+			&iaas.RouteListResponse{
+				Items: &[]iaas.Route{
+					{
+						RouteId: utils.Ptr("rid-1"),
+						Prefix:  utils.Ptr("10.0.0.0/24"),
+						Nexthop: utils.Ptr("192.168.0.1"),
+						Labels: &map[string]interface{}{
+							"key": "value",
+						},
+					},
+					{
+						RouteId: utils.Ptr("rid-2"),
+						Prefix:  utils.Ptr("10.1.0.0/24"),
+						Nexthop: utils.Ptr("192.168.0.2"),
+					},
+				},
+			},
+			Model{
+				Id:             types.StringValue("oid,naid"),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Routes: types.SetValueMust(types.ObjectType{AttrTypes: routeTypes}, []attr.Value{
+					types.ObjectValueMust(routeTypes, map[string]attr.Value{
+						"prefix":   types.StringValue("10.0.0.1/24"),
+						"next_hop": types.StringValue("192.168.0.1"),
+						"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"key": types.StringValue("value"),
+						}),
+					}),
+					types.ObjectValueMust(routeTypes, map[string]attr.Value{
+						"prefix":   types.StringValue("10.1.0.0/24"),
+						"next_hop": types.StringValue("192.168.0.2"),
+						"labels":   types.MapNull(types.StringType),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			Model{},
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMatchRoutes(t *testing.T) {
+	first := &route{prefix: "10.0.0.0/24", nextHop: "192.168.0.1"}
+	second := &route{prefix: "10.1.0.0/24", nextHop: "192.168.0.1"}
+	firstAPI := func(id string) iaas.Route {
+		return iaas.Route{RouteId: utils.Ptr(id), Prefix: utils.Ptr("10.0.0.0/24"), Nexthop: utils.Ptr("192.168.0.1")}
+	}
+
+	tests := []struct {
+		description       string
+		routes            []*route
+		actual            []iaas.Route
+		expectedMatched   []string
+		expectedMissing   []*route
+		expectedUnmatched []string
+	}{
+		{
+			"in_sync",
+			[]*route{first},
+			[]iaas.Route{firstAPI("a")},
+			[]string{"a"},
+			nil,
+			nil,
+		},
+		{
+			"create_and_delete",
+			[]*route{second},
+			[]iaas.Route{firstAPI("a")},
+			nil,
+			[]*route{second},
+			[]string{"a"},
+		},
+		{
+			"changed_next_hop",
+			[]*route{{prefix: "10.0.0.0/24", nextHop: "192.168.0.2"}},
+			[]iaas.Route{firstAPI("a")},
+			nil,
+			[]*route{{prefix: "10.0.0.0/24", nextHop: "192.168.0.2"}},
+			[]string{"a"},
+		},
+		{
+			"duplicate_route_deleted",
+			[]*route{first},
+			[]iaas.Route{firstAPI("a"), firstAPI("b")},
+			[]string{"a"},
+			nil,
+			[]string{"b"},
+		},
+		{
+			"empty",
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			matched, missing, unmatched := matchRoutes(tt.routes, tt.actual)
+			var matchedIds []string
+			for _, m := range matched {
+				matchedIds = append(matchedIds, m.actual.GetRouteId())
+			}
+			diff := cmp.Diff(matchedIds, tt.expectedMatched)
+			if diff != "" {
+				t.Fatalf("Matched routes do not match: %s", diff)
+			}
+			diff = cmp.Diff(missing, tt.expectedMissing, cmp.AllowUnexported(route{}))
+			if diff != "" {
+				t.Fatalf("Missing routes do not match: %s", diff)
+			}
+			var unmatchedIds []string
+			for _, r := range unmatched {
+				unmatchedIds = append(unmatchedIds, r.GetRouteId())
+			}
+			diff = cmp.Diff(unmatchedIds, tt.expectedUnmatched)
+			if diff != "" {
+				t.Fatalf("Unmatched routes do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSplitConflictingRoutes(t *testing.T) {
+	apiRoute := func(id, prefix string) iaas.Route {
+		return iaas.Route{RouteId: utils.Ptr(id), Prefix: utils.Ptr(prefix), Nexthop: utils.Ptr("192.168.0.1")}
+	}
+
+	tests := []struct {
+		description         string
+		missing             []*route
+		unmatched           []iaas.Route
+		expectedConflicting []string
+		expectedObsolete    []string
+	}{
+		{
+			"changed_next_hop",
+			[]*route{{prefix: "10.0.0.0/24", nextHop: "192.168.0.2"}},
+			[]iaas.Route{apiRoute("a", "10.0.0.0/24")},
+			[]string{"a"},
+			nil,
+		},
+		{
+			"changed_prefix",
+			[]*route{{prefix: "10.1.0.0/24", nextHop: "192.168.0.1"}},
+			[]iaas.Route{apiRoute("a", "10.0.0.0/24")},
+			nil,
+			[]string{"a"},
+		},
+		{
+			"different_prefix_notation",
+			[]*route{{prefix: "10.0.0.1/24", nextHop: "192.168.0.2"}},
+			[]iaas.Route{apiRoute("a", "10.0.0.0/24"), apiRoute("b", "10.2.0.0/24")},
+			[]string{"a"},
+			[]string{"b"},
+		},
+		{
+			"nothing_missing",
+			nil,
+			[]iaas.Route{apiRoute("a", "10.0.0.0/24")},
+			nil,
+			[]string{"a"},
+		},
+		{
+			"empty",
+			nil,
+			nil,
+			nil,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			conflicting, obsolete := splitConflictingRoutes(tt.missing, tt.unmatched)
+			var conflictingIds []string
+			for _, r := range conflicting {
+				conflictingIds = append(conflictingIds, r.GetRouteId())
+			}
+			diff := cmp.Diff(conflictingIds, tt.expectedConflicting)
+			if diff != "" {
+				t.Fatalf("Conflicting routes do not match: %s", diff)
+			}
+			var obsoleteIds []string
+			for _, r := range obsolete {
+				obsoleteIds = append(obsoleteIds, r.GetRouteId())
+			}
+			diff = cmp.Diff(obsoleteIds, tt.expectedObsolete)
+			if diff != "" {
+				t.Fatalf("Obsolete routes do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLabelsEqual(t *testing.T) {
+	tests := []struct {
+		description string
+		configured  map[string]interface{}
+		actual      *map[string]interface{}
+		expected    bool
+	}{
+		{
+			"both_empty",
+			map[string]interface{}{},
+			nil,
+			true,
+		},
+		{
+			"equal",
+			map[string]interface{}{"key": "value"},
+			&map[string]interface{}{"key": "value"},
+			true,
+		},
+		{
+			"changed_value",
+			map[string]interface{}{"key": "value"},
+			&map[string]interface{}{"key": "other"},
+			false,
+		},
+		{
+			"removed_label",
+			map[string]interface{}{},
+			&map[string]interface{}{"key": "value"},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := labelsEqual(tt.configured, tt.actual)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []*route
+		expected    *iaas.CreateNetworkAreaRoutePayload
+	}{
+		{
+			"simple_values",
+			[]*route{
+				{
+					prefix:  "10.0.0.0/24",
+					nextHop: "192.168.0.1",
+					labels:  map[string]interface{}{"key": "value"},
+				},
+				{
+					prefix:  "10.1.0.0/24",
+					nextHop: "192.168.0.2",
+					labels:  map[string]interface{}{},
+				},
+			},
+			&iaas.CreateNetworkAreaRoutePayload{
+				Ipv4: &[]iaas.Route{
+					{
+						Prefix:  utils.Ptr("10.0.0.0/24"),
+						Nexthop: utils.Ptr("192.168.0.1"),
+						Labels:  &map[string]interface{}{"key": "value"},
+					},
+					{
+						Prefix:  utils.Ptr("10.1.0.0/24"),
+						Nexthop: utils.Ptr("192.168.0.2"),
+						Labels:  &map[string]interface{}{},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toCreatePayload(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		configured  *route
+		actual      *iaas.Route
+		expected    *iaas.UpdateNetworkAreaRoutePayload
+		isValid     bool
+	}{
+		{
+			"labels_changed",
+			&route{
+				labels: map[string]interface{}{
+					"key1": "value1",
+					"key2": "value2",
+				},
+			},
+			&iaas.Route{
+				Labels: &map[string]interface{}{
+					"key1": "old",
+					"key3": "value3",
+				},
+			},
+			&iaas.UpdateNetworkAreaRoutePayload{
+				Labels: &map[string]interface{}{
+					"key1": "value1",
+					"key2": "value2",
+					"key3": nil,
+				},
+			},
+			true,
+		},
+		{
+			"nil_route",
+			nil,
+			&iaas.Route{},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toUpdatePayload(context.Background(), tt.configured, tt.actual)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package networkareas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &networkAreasDataSource{}
+	_ datasource.DataSourceWithConfigure = &networkAreasDataSource{}
+)
+
+type DataSourceModel struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	OrganizationId types.String `tfsdk:"organization_id"`
+	Labels         types.Map    `tfsdk:"labels"`
+	NetworkAreas   types.List   `tfsdk:"network_areas"`
+}
+
+// Types corresponding to a single element of DataSourceModel.NetworkAreas
+var networkAreaTypes = map[string]attr.Type{
+	"network_area_id":       types.StringType,
+	"name":                  types.StringType,
+	"project_count":         types.Int64Type,
+	"default_nameservers":   types.ListType{ElemType: types.StringType},
+	"network_ranges":        types.ListType{ElemType: types.ObjectType{AttrTypes: networkRangeTypes}},
+	"transfer_network":      types.StringType,
+	"default_prefix_length": types.Int64Type,
+	"max_prefix_length":     types.Int64Type,
+	"min_prefix_length":     types.Int64Type,
+	"labels":                types.MapType{ElemType: types.StringType},
+}
+
+// Types corresponding to a single element of the network_ranges of a network area
+var networkRangeTypes = map[string]attr.Type{
+	"network_range_id": types.StringType,
+	"prefix":           types.StringType,
+}
+
+// NewNetworkAreasDataSource is a helper function to simplify the provider implementation.
+func NewNetworkAreasDataSource() datasource.DataSource {
+	return &networkAreasDataSource{}
+}
+
+// networkAreasDataSource is the data source implementation.
+type networkAreasDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *networkAreasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_areas"
+}
+
+func (d *networkAreasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "IaaS client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *networkAreasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Network areas datasource schema. Lists the network areas of an organization. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal datasource ID. It is structured as \"`organization_id`\".",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "STACKIT organization ID for which the network areas are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Only network areas which have all of these labels are listed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"network_areas": schema.ListNestedAttribute{
+				Description: "List of network areas, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"network_area_id": schema.StringAttribute{
+							Description: "The network area ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the network area.",
+							Computed:    true,
+						},
+						"project_count": schema.Int64Attribute{
+							Description: "The amount of projects currently referencing this area.",
+							Computed:    true,
+						},
+						"default_nameservers": schema.ListAttribute{
+							Description: "List of DNS Servers/Nameservers.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"network_ranges": schema.ListNestedAttribute{
+							Description: "List of Network ranges.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"network_range_id": schema.StringAttribute{
+										Computed: true,
+									},
+									"prefix": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+						"transfer_network": schema.StringAttribute{
+							Description: "Classless Inter-Domain Routing (CIDR).",
+							Computed:    true,
+						},
+						"default_prefix_length": schema.Int64Attribute{
+							Description: "The default prefix length for networks in the network area.",
+							Computed:    true,
+						},
+						"max_prefix_length": schema.Int64Attribute{
+							Description: "The maximal prefix length for networks in the network area.",
+							Computed:    true,
+						},
+						"min_prefix_length": schema.Int64Attribute{
+							Description: "The minimal prefix length for networks in the network area.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *networkAreasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	organizationId := model.OrganizationId.ValueString()
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+
+	labelSelector, err := iaasUtils.LabelSelector(ctx, model.Labels)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network areas", fmt.Sprintf("Building label selector: %v", err))
+		return
+	}
+	networkAreasReq := d.client.ListNetworkAreas(ctx, organizationId)
+	if labelSelector != "" {
+		networkAreasReq = networkAreasReq.LabelSelector(labelSelector)
+	}
+	networkAreasResp, err := networkAreasReq.Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading network areas",
+			fmt.Sprintf("Network areas cannot be listed for organization %q.", organizationId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Organization with ID %q not found or forbidden access", organizationId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapDataSourceFields(ctx, networkAreasResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network areas", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Network areas read")
+}
+
+func mapDataSourceFields(ctx context.Context, networkAreasResp *iaas.NetworkAreaListResponse, model *DataSourceModel) error {
+	if networkAreasResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.OrganizationId.ValueString())
+
+	networkAreas := make([]iaas.NetworkArea, len(networkAreasResp.GetItems()))
+	copy(networkAreas, networkAreasResp.GetItems())
+	// Sort to get a stable result, the API doesn't guarantee any order
+	sort.SliceStable(networkAreas, func(i, j int) bool {
+		if networkAreas[i].GetName() != networkAreas[j].GetName() {
+			return networkAreas[i].GetName() < networkAreas[j].GetName()
+		}
+		return networkAreas[i].GetAreaId() < networkAreas[j].GetAreaId()
+	})
+
+	networkAreasList := []attr.Value{}
+	for i := range networkAreas {
+		networkArea := networkAreas[i]
+
+		labels, err := iaasUtils.MapLabels(ctx, networkArea.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return fmt.Errorf("mapping labels of index %d: %w", i, err)
+		}
+
+		ipv4 := networkArea.GetIpv4()
+		defaultNameservers := types.ListNull(types.StringType)
+		if ipv4.DefaultNameservers != nil {
+			var diags diag.Diagnostics
+			defaultNameservers, diags = types.ListValueFrom(ctx, types.StringType, *ipv4.DefaultNameservers)
+			if diags.HasError() {
+				return fmt.Errorf("mapping default nameservers of index %d: %w", i, core.DiagsToError(diags))
+			}
+		}
+		networkRanges, err := mapNetworkRanges(ipv4.NetworkRanges)
+		if err != nil {
+			return fmt.Errorf("mapping network ranges of index %d: %w", i, err)
+		}
+
+		networkAreaTF, diags := types.ObjectValue(networkAreaTypes, map[string]attr.Value{
+			"network_area_id":       types.StringPointerValue(networkArea.AreaId),
+			"name":                  types.StringPointerValue(networkArea.Name),
+			"project_count":         types.Int64PointerValue(networkArea.ProjectCount),
+			"default_nameservers":   defaultNameservers,
+			"network_ranges":        networkRanges,
+			"transfer_network":      types.StringPointerValue(ipv4.TransferNetwork),
+			"default_prefix_length": types.Int64PointerValue(ipv4.DefaultPrefixLen),
+			"max_prefix_length":     types.Int64PointerValue(ipv4.MaxPrefixLen),
+			"min_prefix_length":     types.Int64PointerValue(ipv4.MinPrefixLen),
+			"labels":                labels,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		networkAreasList = append(networkAreasList, networkAreaTF)
+	}
+
+	networkAreasTF, diags := types.ListValue(types.ObjectType{AttrTypes: networkAreaTypes}, networkAreasList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.NetworkAreas = networkAreasTF
+	return nil
+}
+
+func mapNetworkRanges(networkRanges *[]iaas.NetworkRange) (types.List, error) {
+	if networkRanges == nil {
+		return types.ListNull(types.ObjectType{AttrTypes: networkRangeTypes}), nil
+	}
+
+	networkRangesList := []attr.Value{}
+	for i, networkRange := range *networkRanges {
+		networkRangeTF, diags := types.ObjectValue(networkRangeTypes, map[string]attr.Value{
+			"network_range_id": types.StringPointerValue(networkRange.NetworkRangeId),
+			"prefix":           types.StringPointerValue(networkRange.Prefix),
+		})
+		if diags.HasError() {
+			return types.ListNull(types.ObjectType{AttrTypes: networkRangeTypes}), fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		networkRangesList = append(networkRangesList, networkRangeTF)
+	}
+
+	networkRangesTF, diags := types.ListValue(types.ObjectType{AttrTypes: networkRangeTypes}, networkRangesList)
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: networkRangeTypes}), core.DiagsToError(diags)
+	}
+	return networkRangesTF, nil
+}
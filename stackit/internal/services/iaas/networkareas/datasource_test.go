@@ -0,0 +1,144 @@
+package networkareas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.NetworkAreaListResponse
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			DataSourceModel{
+				OrganizationId: types.StringValue("oid"),
+				Labels:         types.MapNull(types.StringType),
+			},
+			&iaas.NetworkAreaListResponse{},
+			DataSourceModel{
+				Id:             types.StringValue("oid"),
+				OrganizationId: types.StringValue("oid"),
+				Labels:         types.MapNull(types.StringType),
+				NetworkAreas:   types.ListValueMust(types.ObjectType{AttrTypes: networkAreaTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"simple_values_sorted",
+			DataSourceModel{
+				OrganizationId: types.StringValue("oid"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"env": types.StringValue("prod"),
+				}),
+			},
+			&iaas.NetworkAreaListResponse{
+				Items: &[]iaas.NetworkArea{
+					{
+						AreaId:       utils.Ptr("naid-2"),
+						Name:         utils.Ptr("prod"),
+						ProjectCount: utils.Ptr(int64(2)),
+						Ipv4: &iaas.NetworkAreaIPv4{
+							DefaultNameservers: &[]string{"1.1.1.1"},
+							NetworkRanges: &[]iaas.NetworkRange{
+								{
+									NetworkRangeId: utils.Ptr("nrid-1"),
+									Prefix:         utils.Ptr("10.0.0.0/16"),
+								},
+							},
+							TransferNetwork:  utils.Ptr("192.168.0.0/24"),
+							DefaultPrefixLen: utils.Ptr(int64(25)),
+							MaxPrefixLen:     utils.Ptr(int64(29)),
+							MinPrefixLen:     utils.Ptr(int64(24)),
+						},
+						Labels: &map[string]interface{}{
+							"env": "prod",
+						},
+					},
+					{
+						AreaId:       utils.Ptr("naid-1"),
+						Name:         utils.Ptr("dev"),
+						ProjectCount: utils.Ptr(int64(0)),
+					},
+				},
+			},
+			DataSourceModel{
+				Id:             types.StringValue("oid"),
+				OrganizationId: types.StringValue("oid"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"env": types.StringValue("prod"),
+				}),
+				NetworkAreas: types.ListValueMust(types.ObjectType{AttrTypes: networkAreaTypes}, []attr.Value{
+					types.ObjectValueMust(networkAreaTypes, map[string]attr.Value{
+						"network_area_id":       types.StringValue("naid-1"),
+						"name":                  types.StringValue("dev"),
+						"project_count":         types.Int64Value(0),
+						"default_nameservers":   types.ListNull(types.StringType),
+						"network_ranges":        types.ListNull(types.ObjectType{AttrTypes: networkRangeTypes}),
+						"transfer_network":      types.StringNull(),
+						"default_prefix_length": types.Int64Null(),
+						"max_prefix_length":     types.Int64Null(),
+						"min_prefix_length":     types.Int64Null(),
+						"labels":                types.MapNull(types.StringType),
+					}),
+					types.ObjectValueMust(networkAreaTypes, map[string]attr.Value{
+						"network_area_id": types.StringValue("naid-2"),
+						"name":            types.StringValue("prod"),
+						"project_count":   types.Int64Value(2),
+						"default_nameservers": types.ListValueMust(types.StringType, []attr.Value{
+							types.StringValue("1.1.1.1"),
+						}),
+						"network_ranges": types.ListValueMust(types.ObjectType{AttrTypes: networkRangeTypes}, []attr.Value{
+							types.ObjectValueMust(networkRangeTypes, map[string]attr.Value{
+								"network_range_id": types.StringValue("nrid-1"),
+								"prefix":           types.StringValue("10.0.0.0/16"),
+							}),
+						}),
+						"transfer_network":      types.StringValue("192.168.0.0/24"),
+						"default_prefix_length": types.Int64Value(25),
+						"max_prefix_length":     types.Int64Value(29),
+						"min_prefix_length":     types.Int64Value(24),
+						"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"env": types.StringValue("prod"),
+						}),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			DataSourceModel{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -44,6 +44,7 @@ type Model struct {
 	Name               types.String `tfsdk:"name"`
 	AllowedAddresses   types.List   `tfsdk:"allowed_addresses"`
 	IPv4               types.String `tfsdk:"ipv4"`
+	IPv6               types.String `tfsdk:"ipv6"`
 	Labels             types.Map    `tfsdk:"labels"`
 	Security           types.Bool   `tfsdk:"security"`
 	SecurityGroupIds   types.List   `tfsdk:"security_group_ids"`
@@ -180,7 +181,7 @@ func (r *networkInterfaceResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"allowed_addresses": schema.ListAttribute{
-				Description: "The list of CIDR (Classless Inter-Domain Routing) notations.",
+				Description: "The list of CIDR (Classless Inter-Domain Routing) notations. IPv4 and IPv6 prefixes are supported.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
@@ -206,6 +207,20 @@ func (r *networkInterfaceResource) Schema(_ context.Context, _ resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"ipv6": schema.StringAttribute{
+				Description: "The IPv6 address. Can only be set if the network has an IPv6 prefix.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.IP(false),
+				},
+				PlanModifiers: []planmodifier.String{
+					// ipv6 is null for interfaces without an IPv6 address and planned as unknown on every update,
+					// so only replace the interface if the address is configured
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"labels": schema.MapAttribute{
 				Description: "Labels are key-value string pairs which can be attached to a network interface.",
 				ElementType: types.StringType,
@@ -523,6 +538,7 @@ func mapFields(ctx context.Context, networkInterfaceResp *iaas.NIC, model *Model
 	model.NetworkInterfaceId = types.StringValue(networkInterfaceId)
 	model.Name = networkInterfaceName
 	model.IPv4 = types.StringPointerValue(networkInterfaceResp.Ipv4)
+	model.IPv6 = types.StringPointerValue(networkInterfaceResp.Ipv6)
 	model.Security = types.BoolPointerValue(networkInterfaceResp.NicSecurity)
 	model.Device = types.StringPointerValue(networkInterfaceResp.Device)
 	model.Mac = types.StringPointerValue(networkInterfaceResp.Mac)
@@ -581,6 +597,7 @@ func toCreatePayload(ctx context.Context, model *Model) (*iaas.CreateNicPayload,
 		Name:             conversion.StringValueToPointer(model.Name),
 		Device:           conversion.StringValueToPointer(model.Device),
 		Ipv4:             conversion.StringValueToPointer(model.IPv4),
+		Ipv6:             conversion.StringValueToPointer(model.IPv6),
 		Mac:              conversion.StringValueToPointer(model.Mac),
 		Type:             conversion.StringValueToPointer(model.Type),
 		NicSecurity:      conversion.BoolValueToPointer(model.Security),
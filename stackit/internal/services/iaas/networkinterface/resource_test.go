@@ -6,7 +6,13 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 )
@@ -38,6 +44,7 @@ func TestMapFields(t *testing.T) {
 				AllowedAddresses:   types.ListNull(types.StringType),
 				SecurityGroupIds:   types.ListNull(types.StringType),
 				IPv4:               types.StringNull(),
+				IPv6:               types.StringNull(),
 				Security:           types.BoolNull(),
 				Device:             types.StringNull(),
 				Mac:                types.StringNull(),
@@ -90,6 +97,7 @@ func TestMapFields(t *testing.T) {
 					types.StringValue("prefix2"),
 				}),
 				IPv4:     types.StringValue("ipv4"),
+				IPv6:     types.StringValue("ipv6"),
 				Security: types.BoolValue(true),
 				Device:   types.StringValue("device"),
 				Mac:      types.StringValue("mac"),
@@ -98,6 +106,38 @@ func TestMapFields(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"ipv6_only",
+			Model{
+				ProjectId:          types.StringValue("pid"),
+				NetworkId:          types.StringValue("nid"),
+				NetworkInterfaceId: types.StringValue("nicid"),
+			},
+			&iaas.NIC{
+				Id: utils.Ptr("nicid"),
+				AllowedAddresses: &[]iaas.AllowedAddressesInner{
+					{
+						String: utils.Ptr("2001:db8::/64"),
+					},
+				},
+				Ipv6: utils.Ptr("2001:db8::10"),
+			},
+			Model{
+				Id:                 types.StringValue("pid,nid,nicid"),
+				ProjectId:          types.StringValue("pid"),
+				NetworkId:          types.StringValue("nid"),
+				NetworkInterfaceId: types.StringValue("nicid"),
+				Name:               types.StringNull(),
+				AllowedAddresses: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("2001:db8::/64"),
+				}),
+				SecurityGroupIds: types.ListNull(types.StringType),
+				IPv4:             types.StringNull(),
+				IPv6:             types.StringValue("2001:db8::10"),
+				Labels:           types.MapNull(types.StringType),
+			},
+			true,
+		},
 		{
 			"allowed_addresses_changed_outside_tf",
 			Model{
@@ -225,6 +265,33 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"dual_stack",
+			&Model{
+				Name: types.StringValue("name"),
+				AllowedAddresses: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("10.0.0.0/24"),
+					types.StringValue("2001:db8::/64"),
+				}),
+				IPv4: types.StringValue("10.0.0.10"),
+				IPv6: types.StringValue("2001:db8::10"),
+			},
+			&iaas.CreateNicPayload{
+				Name:           utils.Ptr("name"),
+				SecurityGroups: &[]string{},
+				AllowedAddresses: &[]iaas.AllowedAddressesInner{
+					{
+						String: utils.Ptr("10.0.0.0/24"),
+					},
+					{
+						String: utils.Ptr("2001:db8::/64"),
+					},
+				},
+				Ipv4: utils.Ptr("10.0.0.10"),
+				Ipv6: utils.Ptr("2001:db8::10"),
+			},
+			true,
+		},
 		{
 			"empty_allowed_addresses",
 			&Model{
@@ -341,3 +408,85 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestIPv6PlanModifiers(t *testing.T) {
+	tests := []struct {
+		description     string
+		state           types.String
+		config          types.String
+		plan            types.String
+		expectedPlan    types.String
+		requiresReplace bool
+	}{
+		{
+			// e.g. a labels-only update of an IPv4-only network interface
+			"ipv4_only_update",
+			types.StringNull(),
+			types.StringNull(),
+			types.StringUnknown(),
+			types.StringNull(),
+			false,
+		},
+		{
+			"ipv6_not_configured_update",
+			types.StringValue("2001:db8::1"),
+			types.StringNull(),
+			types.StringUnknown(),
+			types.StringValue("2001:db8::1"),
+			false,
+		},
+		{
+			"ipv6_unchanged",
+			types.StringValue("2001:db8::1"),
+			types.StringValue("2001:db8::1"),
+			types.StringValue("2001:db8::1"),
+			types.StringValue("2001:db8::1"),
+			false,
+		},
+		{
+			"ipv6_changed",
+			types.StringValue("2001:db8::1"),
+			types.StringValue("2001:db8::2"),
+			types.StringValue("2001:db8::2"),
+			types.StringValue("2001:db8::2"),
+			true,
+		},
+	}
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewNetworkInterfaceResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	ipv6Attribute, ok := schemaResp.Schema.Attributes["ipv6"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("ipv6 is not a string attribute")
+	}
+	// existing resource which is updated in place
+	raw := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Path:        path.Root("ipv6"),
+				State:       tfsdk.State{Raw: raw},
+				Plan:        tfsdk.Plan{Raw: raw},
+				Config:      tfsdk.Config{Raw: raw},
+				StateValue:  tt.state,
+				ConfigValue: tt.config,
+				PlanValue:   tt.plan,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: tt.plan}
+			for _, modifier := range ipv6Attribute.PlanModifiers {
+				modifier.PlanModifyString(ctx, req, resp)
+				req.PlanValue = resp.PlanValue
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+			if resp.RequiresReplace != tt.requiresReplace {
+				t.Fatalf("Expected requires replace %t, got %t", tt.requiresReplace, resp.RequiresReplace)
+			}
+			if !resp.PlanValue.Equal(tt.expectedPlan) {
+				t.Fatalf("Expected plan value %s, got %s", tt.expectedPlan, resp.PlanValue)
+			}
+		})
+	}
+}
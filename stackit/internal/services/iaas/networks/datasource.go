@@ -0,0 +1,283 @@
+package networks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &networksDataSource{}
+	_ datasource.DataSourceWithConfigure = &networksDataSource{}
+)
+
+type DataSourceModel struct {
+	Id        types.String `tfsdk:"id"` // needed by TF
+	ProjectId types.String `tfsdk:"project_id"`
+	Labels    types.Map    `tfsdk:"labels"`
+	Networks  types.List   `tfsdk:"networks"`
+}
+
+// Types corresponding to a single element of DataSourceModel.Networks
+var networkTypes = map[string]attr.Type{
+	"network_id":       types.StringType,
+	"name":             types.StringType,
+	"ipv4_prefixes":    types.ListType{ElemType: types.StringType},
+	"ipv4_gateway":     types.StringType,
+	"ipv4_nameservers": types.ListType{ElemType: types.StringType},
+	"ipv6_prefixes":    types.ListType{ElemType: types.StringType},
+	"ipv6_gateway":     types.StringType,
+	"ipv6_nameservers": types.ListType{ElemType: types.StringType},
+	"public_ip":        types.StringType,
+	"routed":           types.BoolType,
+	"labels":           types.MapType{ElemType: types.StringType},
+}
+
+// NewNetworksDataSource is a helper function to simplify the provider implementation.
+func NewNetworksDataSource() datasource.DataSource {
+	return &networksDataSource{}
+}
+
+// networksDataSource is the data source implementation.
+type networksDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *networksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks"
+}
+
+func (d *networksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *networksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Networks datasource schema. Lists the networks of a project. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal datasource ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID for which the networks are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Only networks which have all of these labels are listed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"networks": schema.ListNestedAttribute{
+				Description: "List of networks, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"network_id": schema.StringAttribute{
+							Description: "The network ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the network.",
+							Computed:    true,
+						},
+						"ipv4_prefixes": schema.ListAttribute{
+							Description: "The IPv4 prefixes of the network.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"ipv4_gateway": schema.StringAttribute{
+							Description: "The IPv4 gateway of a network. If not specified, the first IP of the network will be assigned as the gateway.",
+							Computed:    true,
+						},
+						"ipv4_nameservers": schema.ListAttribute{
+							Description: "The IPv4 nameservers of the network.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"ipv6_prefixes": schema.ListAttribute{
+							Description: "The IPv6 prefixes of the network.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"ipv6_gateway": schema.StringAttribute{
+							Description: "The IPv6 gateway of a network. If not specified, the first IP of the network will be assigned as the gateway.",
+							Computed:    true,
+						},
+						"ipv6_nameservers": schema.ListAttribute{
+							Description: "The IPv6 nameservers of the network.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"public_ip": schema.StringAttribute{
+							Description: "The public IP of the network.",
+							Computed:    true,
+						},
+						"routed": schema.BoolAttribute{
+							Description: "Shows if the network is routed and therefore accessible from other networks.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *networksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	labelSelector, err := iaasUtils.LabelSelector(ctx, model.Labels)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading networks", fmt.Sprintf("Building label selector: %v", err))
+		return
+	}
+	networksReq := d.client.ListNetworks(ctx, projectId)
+	if labelSelector != "" {
+		networksReq = networksReq.LabelSelector(labelSelector)
+	}
+	networksResp, err := networksReq.Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading networks",
+			fmt.Sprintf("Networks cannot be listed for project %q.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapDataSourceFields(ctx, networksResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading networks", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Networks read")
+}
+
+func mapDataSourceFields(ctx context.Context, networksResp *iaas.NetworkListResponse, model *DataSourceModel) error {
+	if networksResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString())
+
+	networks := make([]iaas.Network, len(networksResp.GetItems()))
+	copy(networks, networksResp.GetItems())
+	// Sort to get a stable result, the API doesn't guarantee any order
+	sort.SliceStable(networks, func(i, j int) bool {
+		if networks[i].GetName() != networks[j].GetName() {
+			return networks[i].GetName() < networks[j].GetName()
+		}
+		return networks[i].GetNetworkId() < networks[j].GetNetworkId()
+	})
+
+	networksList := []attr.Value{}
+	for i := range networks {
+		network := networks[i]
+
+		labels, err := iaasUtils.MapLabels(ctx, network.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return fmt.Errorf("mapping labels of index %d: %w", i, err)
+		}
+		stringLists := map[string]*[]string{
+			"ipv4_prefixes":    network.Prefixes,
+			"ipv4_nameservers": network.Nameservers,
+			"ipv6_prefixes":    network.PrefixesV6,
+			"ipv6_nameservers": network.NameserversV6,
+		}
+		attributes := map[string]attr.Value{
+			"network_id":   types.StringPointerValue(network.NetworkId),
+			"name":         types.StringPointerValue(network.Name),
+			"ipv4_gateway": types.StringPointerValue(network.GetGateway()),
+			"ipv6_gateway": types.StringPointerValue(network.GetGatewayv6()),
+			"public_ip":    types.StringPointerValue(network.PublicIp),
+			"routed":       types.BoolPointerValue(network.Routed),
+			"labels":       labels,
+		}
+		for key, value := range stringLists {
+			attributes[key] = types.ListNull(types.StringType)
+			if value == nil {
+				continue
+			}
+			listTF, diags := types.ListValueFrom(ctx, types.StringType, *value)
+			if diags.HasError() {
+				return fmt.Errorf("mapping %s of index %d: %w", key, i, core.DiagsToError(diags))
+			}
+			attributes[key] = listTF
+		}
+
+		networkTF, diags := types.ObjectValue(networkTypes, attributes)
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		networksList = append(networksList, networkTF)
+	}
+
+	networksTF, diags := types.ListValue(types.ObjectType{AttrTypes: networkTypes}, networksList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Networks = networksTF
+	return nil
+}
@@ -0,0 +1,142 @@
+package networks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.NetworkListResponse
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				Labels:    types.MapNull(types.StringType),
+			},
+			&iaas.NetworkListResponse{},
+			DataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Labels:    types.MapNull(types.StringType),
+				Networks:  types.ListValueMust(types.ObjectType{AttrTypes: networkTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"simple_values_sorted",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"env": types.StringValue("prod"),
+				}),
+			},
+			&iaas.NetworkListResponse{
+				Items: &[]iaas.Network{
+					{
+						NetworkId:     utils.Ptr("nid-2"),
+						Name:          utils.Ptr("web"),
+						Prefixes:      &[]string{"10.0.0.0/24"},
+						Gateway:       iaas.NewNullableString(utils.Ptr("10.0.0.1")),
+						Nameservers:   &[]string{"1.1.1.1", "8.8.8.8"},
+						PrefixesV6:    &[]string{"2001:db8::/64"},
+						Gatewayv6:     iaas.NewNullableString(utils.Ptr("2001:db8::1")),
+						NameserversV6: &[]string{"2001:4860:4860::8888"},
+						PublicIp:      utils.Ptr("192.0.2.1"),
+						Routed:        utils.Ptr(true),
+						Labels: &map[string]interface{}{
+							"env": "prod",
+						},
+					},
+					{
+						NetworkId: utils.Ptr("nid-1"),
+						Name:      utils.Ptr("db"),
+						Routed:    utils.Ptr(false),
+					},
+				},
+			},
+			DataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"env": types.StringValue("prod"),
+				}),
+				Networks: types.ListValueMust(types.ObjectType{AttrTypes: networkTypes}, []attr.Value{
+					types.ObjectValueMust(networkTypes, map[string]attr.Value{
+						"network_id":       types.StringValue("nid-1"),
+						"name":             types.StringValue("db"),
+						"ipv4_prefixes":    types.ListNull(types.StringType),
+						"ipv4_gateway":     types.StringNull(),
+						"ipv4_nameservers": types.ListNull(types.StringType),
+						"ipv6_prefixes":    types.ListNull(types.StringType),
+						"ipv6_gateway":     types.StringNull(),
+						"ipv6_nameservers": types.ListNull(types.StringType),
+						"public_ip":        types.StringNull(),
+						"routed":           types.BoolValue(false),
+						"labels":           types.MapNull(types.StringType),
+					}),
+					types.ObjectValueMust(networkTypes, map[string]attr.Value{
+						"network_id": types.StringValue("nid-2"),
+						"name":       types.StringValue("web"),
+						"ipv4_prefixes": types.ListValueMust(types.StringType, []attr.Value{
+							types.StringValue("10.0.0.0/24"),
+						}),
+						"ipv4_gateway": types.StringValue("10.0.0.1"),
+						"ipv4_nameservers": types.ListValueMust(types.StringType, []attr.Value{
+							types.StringValue("1.1.1.1"),
+							types.StringValue("8.8.8.8"),
+						}),
+						"ipv6_prefixes": types.ListValueMust(types.StringType, []attr.Value{
+							types.StringValue("2001:db8::/64"),
+						}),
+						"ipv6_gateway": types.StringValue("2001:db8::1"),
+						"ipv6_nameservers": types.ListValueMust(types.StringType, []attr.Value{
+							types.StringValue("2001:4860:4860::8888"),
+						}),
+						"public_ip": types.StringValue("192.0.2.1"),
+						"routed":    types.BoolValue(true),
+						"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"env": types.StringValue("prod"),
+						}),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			DataSourceModel{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
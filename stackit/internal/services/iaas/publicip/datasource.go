@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,7 +24,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &publicIpDataSource{}
+	_ datasource.DataSource                     = &publicIpDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &publicIpDataSource{}
 )
 
 // NewVolumeDataSource is a helper function to simplify the provider implementation.
@@ -39,6 +43,16 @@ func (d *publicIpDataSource) Metadata(_ context.Context, req datasource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_public_ip"
 }
 
+// ConfigValidators validates the data source configuration
+func (d *publicIpDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("public_ip_id"),
+			path.MatchRoot("ip"),
+		),
+	}
+}
+
 func (d *publicIpDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
 	if !ok {
@@ -55,7 +69,7 @@ func (d *publicIpDataSource) Configure(ctx context.Context, req datasource.Confi
 
 // Schema defines the schema for the resource.
 func (r *publicIpDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	description := "Public IP resource schema. Must have a `region` specified in the provider configuration."
+	description := "Public IP resource schema. The public IP is looked up either by `public_ip_id` or by `ip`. Must have a `region` specified in the provider configuration."
 	resp.Schema = schema.Schema{
 		MarkdownDescription: description,
 		Description:         description,
@@ -73,16 +87,21 @@ func (r *publicIpDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				},
 			},
 			"public_ip_id": schema.StringAttribute{
-				Description: "The public IP ID.",
-				Required:    true,
+				Description: "The public IP ID. Either `public_ip_id` or `ip` must be provided.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
 					validate.UUID(),
 					validate.NoSeparator(),
 				},
 			},
 			"ip": schema.StringAttribute{
-				Description: "The IP address.",
+				Description: "The IP address. Either `public_ip_id` or `ip` must be provided.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					validate.IP(false),
+				},
 			},
 			"network_interface_id": schema.StringAttribute{
 				Description: "Associates the public IP with a network interface or a virtual IP (ID).",
@@ -111,23 +130,51 @@ func (d *publicIpDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 	projectId := model.ProjectId.ValueString()
 	publicIpId := model.PublicIpId.ValueString()
+	ip := model.Ip.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "public_ip_id", publicIpId)
+	ctx = tflog.SetField(ctx, "ip", ip)
 
-	publicIpResp, err := d.client.GetPublicIP(ctx, projectId, publicIpId).Execute()
-	if err != nil {
-		utils.LogError(
-			ctx,
-			&resp.Diagnostics,
-			err,
-			"Reading public ip",
-			fmt.Sprintf("Public ip with ID %q does not exist in project %q.", publicIpId, projectId),
-			map[int]string{
-				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
-			},
-		)
-		resp.State.RemoveResource(ctx)
-		return
+	var publicIpResp *iaas.PublicIp
+	var err error
+	if publicIpId != "" {
+		publicIpResp, err = d.client.GetPublicIP(ctx, projectId, publicIpId).Execute()
+		if err != nil {
+			utils.LogError(
+				ctx,
+				&resp.Diagnostics,
+				err,
+				"Reading public ip",
+				fmt.Sprintf("Public ip with ID %q does not exist in project %q.", publicIpId, projectId),
+				map[int]string{
+					http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+				},
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	} else {
+		publicIpsResp, err := d.client.ListPublicIPsExecute(ctx, projectId)
+		if err != nil {
+			utils.LogError(
+				ctx,
+				&resp.Diagnostics,
+				err,
+				"Reading public ip",
+				fmt.Sprintf("Public ips cannot be listed for project %q.", projectId),
+				map[int]string{
+					http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+				},
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		publicIpResp, err = findByIp(publicIpsResp, ip)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading public IP", fmt.Sprintf("Looking up public IP by address in project %q: %v", projectId, err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 	}
 
 	err = mapFields(ctx, publicIpResp, &model)
@@ -135,6 +182,10 @@ func (d *publicIpDataSource) Read(ctx context.Context, req datasource.ReadReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading public IP", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	if ip != "" {
+		// Keep the configured notation of the address
+		model.Ip = types.StringValue(ip)
+	}
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -142,3 +193,24 @@ func (d *publicIpDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 	tflog.Info(ctx, "public IP read")
 }
+
+// findByIp returns the public IP with the given address
+func findByIp(publicIpsResp *iaas.PublicIpListResponse, ip string) (*iaas.PublicIp, error) {
+	if publicIpsResp == nil {
+		return nil, fmt.Errorf("response input is nil")
+	}
+	address, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil, fmt.Errorf("parsing IP address: %w", err)
+	}
+
+	for i := range publicIpsResp.GetItems() {
+		publicIp := &(*publicIpsResp.Items)[i]
+		// Compare parsed addresses, IPv6 addresses can have different notations
+		publicIpAddress, err := netip.ParseAddr(publicIp.GetIp())
+		if err == nil && publicIpAddress == address {
+			return publicIp, nil
+		}
+	}
+	return nil, fmt.Errorf("public IP with address %q not found", ip)
+}
@@ -0,0 +1,85 @@
+package publicip
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestFindByIp(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.PublicIpListResponse
+		ip          string
+		expected    *iaas.PublicIp
+		isValid     bool
+	}{
+		{
+			"found",
+			&iaas.PublicIpListResponse{
+				Items: &[]iaas.PublicIp{
+					{Id: utils.Ptr("pipid-1"), Ip: utils.Ptr("192.0.2.1")},
+					{Id: utils.Ptr("pipid-2"), Ip: utils.Ptr("192.0.2.2")},
+				},
+			},
+			"192.0.2.2",
+			&iaas.PublicIp{Id: utils.Ptr("pipid-2"), Ip: utils.Ptr("192.0.2.2")},
+			true,
+		},
+		{
+			"ipv6_different_notation",
+			&iaas.PublicIpListResponse{
+				Items: &[]iaas.PublicIp{
+					{Id: utils.Ptr("pipid-1"), Ip: utils.Ptr("2001:db8:0:0:0:0:0:1")},
+				},
+			},
+			"2001:db8::1",
+			&iaas.PublicIp{Id: utils.Ptr("pipid-1"), Ip: utils.Ptr("2001:db8:0:0:0:0:0:1")},
+			true,
+		},
+		{
+			"not_found",
+			&iaas.PublicIpListResponse{
+				Items: &[]iaas.PublicIp{
+					{Id: utils.Ptr("pipid-1"), Ip: utils.Ptr("192.0.2.1")},
+				},
+			},
+			"192.0.2.2",
+			nil,
+			false,
+		},
+		{
+			"invalid_ip",
+			&iaas.PublicIpListResponse{},
+			"foo",
+			nil,
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			"192.0.2.1",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := findByIp(tt.input, tt.ip)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package publicips
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"sort"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &publicIpsDataSource{}
+	_ datasource.DataSourceWithConfigure = &publicIpsDataSource{}
+)
+
+type DataSourceModel struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	ProjectId      types.String `tfsdk:"project_id"`
+	Labels         types.Map    `tfsdk:"labels"`
+	UnassignedOnly types.Bool   `tfsdk:"unassigned_only"`
+	PublicIps      types.List   `tfsdk:"public_ips"`
+}
+
+// Types corresponding to a single element of DataSourceModel.PublicIps
+var publicIpTypes = map[string]attr.Type{
+	"public_ip_id":         types.StringType,
+	"ip":                   types.StringType,
+	"network_interface_id": types.StringType,
+	"labels":               types.MapType{ElemType: types.StringType},
+}
+
+// NewPublicIpsDataSource is a helper function to simplify the provider implementation.
+func NewPublicIpsDataSource() datasource.DataSource {
+	return &publicIpsDataSource{}
+}
+
+// publicIpsDataSource is the data source implementation.
+type publicIpsDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *publicIpsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_public_ips"
+}
+
+func (d *publicIpsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *publicIpsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Public IPs datasource schema. Lists the public IPs allocated in a project, e.g. to find unassigned IPs which can be reused or released. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal datasource ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID for which the public IPs are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Only public IPs which have all of these labels are listed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"unassigned_only": schema.BoolAttribute{
+				Description: "If set to `true`, only public IPs which aren't associated with a network interface are listed. Defaults to `false`.",
+				Optional:    true,
+			},
+			"public_ips": schema.ListNestedAttribute{
+				Description: "List of public IPs, sorted by IP address.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"public_ip_id": schema.StringAttribute{
+							Description: "The public IP ID.",
+							Computed:    true,
+						},
+						"ip": schema.StringAttribute{
+							Description: "The IP address.",
+							Computed:    true,
+						},
+						"network_interface_id": schema.StringAttribute{
+							Description: "The ID of the network interface or virtual IP the public IP is associated with. Not set if the public IP is unassigned.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *publicIpsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	labelSelector, err := iaasUtils.LabelSelector(ctx, model.Labels)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading public IPs", fmt.Sprintf("Building label selector: %v", err))
+		return
+	}
+	publicIpsReq := d.client.ListPublicIPs(ctx, projectId)
+	if labelSelector != "" {
+		publicIpsReq = publicIpsReq.LabelSelector(labelSelector)
+	}
+	publicIpsResp, err := publicIpsReq.Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading public IPs",
+			fmt.Sprintf("Public IPs cannot be listed for project %q.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapDataSourceFields(ctx, publicIpsResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading public IPs", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Public IPs read")
+}
+
+func mapDataSourceFields(ctx context.Context, publicIpsResp *iaas.PublicIpListResponse, model *DataSourceModel) error {
+	if publicIpsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString())
+
+	publicIps := []iaas.PublicIp{}
+	for _, publicIp := range publicIpsResp.GetItems() {
+		if model.UnassignedOnly.ValueBool() && isAssigned(&publicIp) {
+			continue
+		}
+		publicIps = append(publicIps, publicIp)
+	}
+	// Sort to get a stable result, the API doesn't guarantee any order
+	sort.SliceStable(publicIps, func(i, j int) bool {
+		addressI, errI := netip.ParseAddr(publicIps[i].GetIp())
+		addressJ, errJ := netip.ParseAddr(publicIps[j].GetIp())
+		if errI != nil || errJ != nil {
+			return publicIps[i].GetIp() < publicIps[j].GetIp()
+		}
+		return addressI.Less(addressJ)
+	})
+
+	publicIpsList := []attr.Value{}
+	for i := range publicIps {
+		publicIp := publicIps[i]
+
+		labels, err := iaasUtils.MapLabels(ctx, publicIp.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return fmt.Errorf("mapping labels of index %d: %w", i, err)
+		}
+		networkInterfaceId := types.StringNull()
+		if isAssigned(&publicIp) {
+			networkInterfaceId = types.StringPointerValue(publicIp.GetNetworkInterface())
+		}
+
+		publicIpTF, diags := types.ObjectValue(publicIpTypes, map[string]attr.Value{
+			"public_ip_id":         types.StringPointerValue(publicIp.Id),
+			"ip":                   types.StringPointerValue(publicIp.Ip),
+			"network_interface_id": networkInterfaceId,
+			"labels":               labels,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		publicIpsList = append(publicIpsList, publicIpTF)
+	}
+
+	publicIpsTF, diags := types.ListValue(types.ObjectType{AttrTypes: publicIpTypes}, publicIpsList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.PublicIps = publicIpsTF
+	return nil
+}
+
+// isAssigned reports whether the public IP is associated with a network interface
+func isAssigned(publicIp *iaas.PublicIp) bool {
+	networkInterface := publicIp.GetNetworkInterface()
+	return networkInterface != nil && *networkInterface != ""
+}
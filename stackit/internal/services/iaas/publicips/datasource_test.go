@@ -0,0 +1,138 @@
+package publicips
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	publicIps := &iaas.PublicIpListResponse{
+		Items: &[]iaas.PublicIp{
+			{
+				Id:               utils.Ptr("pipid-3"),
+				Ip:               utils.Ptr("192.0.2.10"),
+				NetworkInterface: iaas.NewNullableString(utils.Ptr("nicid")),
+			},
+			{
+				Id: utils.Ptr("pipid-2"),
+				Ip: utils.Ptr("192.0.2.2"),
+				Labels: &map[string]interface{}{
+					"env": "prod",
+				},
+				NetworkInterface: iaas.NewNullableString(nil),
+			},
+			{
+				Id: utils.Ptr("pipid-1"),
+				Ip: utils.Ptr("192.0.2.1"),
+			},
+		},
+	}
+	unassigned := []attr.Value{
+		types.ObjectValueMust(publicIpTypes, map[string]attr.Value{
+			"public_ip_id":         types.StringValue("pipid-1"),
+			"ip":                   types.StringValue("192.0.2.1"),
+			"network_interface_id": types.StringNull(),
+			"labels":               types.MapNull(types.StringType),
+		}),
+		types.ObjectValueMust(publicIpTypes, map[string]attr.Value{
+			"public_ip_id":         types.StringValue("pipid-2"),
+			"ip":                   types.StringValue("192.0.2.2"),
+			"network_interface_id": types.StringNull(),
+			"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("prod"),
+			}),
+		}),
+	}
+	assigned := types.ObjectValueMust(publicIpTypes, map[string]attr.Value{
+		"public_ip_id":         types.StringValue("pipid-3"),
+		"ip":                   types.StringValue("192.0.2.10"),
+		"network_interface_id": types.StringValue("nicid"),
+		"labels":               types.MapNull(types.StringType),
+	})
+
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.PublicIpListResponse
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				Labels:    types.MapNull(types.StringType),
+			},
+			&iaas.PublicIpListResponse{},
+			DataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Labels:    types.MapNull(types.StringType),
+				PublicIps: types.ListValueMust(types.ObjectType{AttrTypes: publicIpTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"all_sorted_by_address",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				Labels:    types.MapNull(types.StringType),
+			},
+			publicIps,
+			DataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Labels:    types.MapNull(types.StringType),
+				PublicIps: types.ListValueMust(types.ObjectType{AttrTypes: publicIpTypes}, append(unassigned, assigned)),
+			},
+			true,
+		},
+		{
+			"unassigned_only",
+			DataSourceModel{
+				ProjectId:      types.StringValue("pid"),
+				Labels:         types.MapNull(types.StringType),
+				UnassignedOnly: types.BoolValue(true),
+			},
+			publicIps,
+			DataSourceModel{
+				Id:             types.StringValue("pid"),
+				ProjectId:      types.StringValue("pid"),
+				Labels:         types.MapNull(types.StringType),
+				UnassignedOnly: types.BoolValue(true),
+				PublicIps:      types.ListValueMust(types.ObjectType{AttrTypes: publicIpTypes}, unassigned),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			DataSourceModel{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -8,8 +8,11 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,7 +24,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &securityGroupDataSource{}
+	_ datasource.DataSource                     = &securityGroupDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &securityGroupDataSource{}
 )
 
 // NewSecurityGroupDataSource is a helper function to simplify the provider implementation.
@@ -39,6 +43,16 @@ func (d *securityGroupDataSource) Metadata(_ context.Context, req datasource.Met
 	resp.TypeName = req.ProviderTypeName + "_security_group"
 }
 
+// ConfigValidators validates the data source configuration
+func (d *securityGroupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("security_group_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *securityGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
 	if !ok {
@@ -55,7 +69,7 @@ func (d *securityGroupDataSource) Configure(ctx context.Context, req datasource.
 
 // Schema defines the schema for the resource.
 func (r *securityGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	description := "Security group datasource schema. Must have a `region` specified in the provider configuration."
+	description := "Security group datasource schema. The security group is looked up either by `security_group_id` or by `name`. Must have a `region` specified in the provider configuration."
 	resp.Schema = schema.Schema{
 		MarkdownDescription: description,
 		Description:         description,
@@ -73,16 +87,21 @@ func (r *securityGroupDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				},
 			},
 			"security_group_id": schema.StringAttribute{
-				Description: "The security group ID.",
-				Required:    true,
+				Description: "The security group ID. Either `security_group_id` or `name` must be provided.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
 					validate.UUID(),
 					validate.NoSeparator(),
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the security group.",
+				Description: "The name of the security group. Either `security_group_id` or `name` must be provided. The lookup by name fails if the name isn't unique in the project.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"description": schema.StringAttribute{
 				Description: "The description of the security group.",
@@ -111,23 +130,51 @@ func (d *securityGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 	projectId := model.ProjectId.ValueString()
 	securityGroupId := model.SecurityGroupId.ValueString()
+	name := model.Name.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+	ctx = tflog.SetField(ctx, "name", name)
 
-	securityGroupResp, err := d.client.GetSecurityGroup(ctx, projectId, securityGroupId).Execute()
-	if err != nil {
-		utils.LogError(
-			ctx,
-			&resp.Diagnostics,
-			err,
-			"Reading security group",
-			fmt.Sprintf("Security group with ID %q does not exist in project %q.", securityGroupId, projectId),
-			map[int]string{
-				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
-			},
-		)
-		resp.State.RemoveResource(ctx)
-		return
+	var securityGroupResp *iaas.SecurityGroup
+	var err error
+	if securityGroupId != "" {
+		securityGroupResp, err = d.client.GetSecurityGroup(ctx, projectId, securityGroupId).Execute()
+		if err != nil {
+			utils.LogError(
+				ctx,
+				&resp.Diagnostics,
+				err,
+				"Reading security group",
+				fmt.Sprintf("Security group with ID %q does not exist in project %q.", securityGroupId, projectId),
+				map[int]string{
+					http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+				},
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	} else {
+		securityGroupsResp, err := d.client.ListSecurityGroupsExecute(ctx, projectId)
+		if err != nil {
+			utils.LogError(
+				ctx,
+				&resp.Diagnostics,
+				err,
+				"Reading security group",
+				fmt.Sprintf("Security groups cannot be listed for project %q.", projectId),
+				map[int]string{
+					http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+				},
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		securityGroupResp, err = findByName(securityGroupsResp, name)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group", fmt.Sprintf("Looking up security group by name in project %q: %v", projectId, err))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 	}
 
 	err = mapFields(ctx, securityGroupResp, &model)
@@ -142,3 +189,26 @@ func (d *securityGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 	tflog.Info(ctx, "security group read")
 }
+
+// findByName returns the security group with the given name, the name must be unique
+func findByName(securityGroupsResp *iaas.SecurityGroupListResponse, name string) (*iaas.SecurityGroup, error) {
+	if securityGroupsResp == nil {
+		return nil, fmt.Errorf("response input is nil")
+	}
+
+	var found *iaas.SecurityGroup
+	for i := range securityGroupsResp.GetItems() {
+		securityGroup := &(*securityGroupsResp.Items)[i]
+		if securityGroup.GetName() != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("multiple security groups with name %q found, use `security_group_id` instead", name)
+		}
+		found = securityGroup
+	}
+	if found == nil {
+		return nil, fmt.Errorf("security group with name %q not found", name)
+	}
+	return found, nil
+}
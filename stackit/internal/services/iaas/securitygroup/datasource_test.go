@@ -0,0 +1,86 @@
+package securitygroup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestFindByName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.SecurityGroupListResponse
+		name        string
+		expected    *iaas.SecurityGroup
+		isValid     bool
+	}{
+		{
+			"found",
+			&iaas.SecurityGroupListResponse{
+				Items: &[]iaas.SecurityGroup{
+					{Id: utils.Ptr("sgid-1"), Name: utils.Ptr("default")},
+					{Id: utils.Ptr("sgid-2"), Name: utils.Ptr("web")},
+				},
+			},
+			"web",
+			&iaas.SecurityGroup{Id: utils.Ptr("sgid-2"), Name: utils.Ptr("web")},
+			true,
+		},
+		{
+			"not_found",
+			&iaas.SecurityGroupListResponse{
+				Items: &[]iaas.SecurityGroup{
+					{Id: utils.Ptr("sgid-1"), Name: utils.Ptr("default")},
+				},
+			},
+			"web",
+			nil,
+			false,
+		},
+		{
+			"name_not_unique",
+			&iaas.SecurityGroupListResponse{
+				Items: &[]iaas.SecurityGroup{
+					{Id: utils.Ptr("sgid-1"), Name: utils.Ptr("web")},
+					{Id: utils.Ptr("sgid-2"), Name: utils.Ptr("web")},
+				},
+			},
+			"web",
+			nil,
+			false,
+		},
+		{
+			"no_items",
+			&iaas.SecurityGroupListResponse{},
+			"web",
+			nil,
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			"web",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := findByName(tt.input, tt.name)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
@@ -38,6 +39,7 @@ var (
 	_                       resource.ResourceWithConfigure   = &securityGroupRuleResource{}
 	_                       resource.ResourceWithImportState = &securityGroupRuleResource{}
 	icmpProtocols                                            = []string{"icmp", "ipv6-icmp"}
+	etherTypeOptions                                         = []string{iaasUtils.EtherTypeIPv4, iaasUtils.EtherTypeIPv6}
 	protocolsPossibleValues                                  = []string{
 		"ah", "dccp", "egp", "esp", "gre", "icmp", "igmp", "ipip", "ipv6-encap", "ipv6-frag", "ipv6-icmp",
 		"ipv6-nonxt", "ipv6-opts", "ipv6-route", "ospf", "pgm", "rsvp", "sctp", "tcp", "udp", "udplite", "vrrp",
@@ -131,6 +133,15 @@ func (r securityGroupRuleResource) ValidateConfig(ctx context.Context, req resou
 		return
 	}
 
+	// The ether type must match the IP family of the remote IP range
+	if !utils.IsUndefined(model.EtherType) && !utils.IsUndefined(model.IpRange) && iaasUtils.EtherType(model.IpRange.ValueString()) != model.EtherType.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ip_range"),
+			"Conflicting attribute configuration",
+			fmt.Sprintf("`ip_range` %q doesn't match `ether_type` %q", model.IpRange.ValueString(), model.EtherType.ValueString()),
+		)
+	}
+
 	// If protocol is not configured, return without error.
 	if model.Protocol.IsNull() || model.Protocol.IsUnknown() {
 		return
@@ -237,13 +248,16 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 			"ether_type": schema.StringAttribute{
-				Description: "The ethertype which the rule should match.",
+				Description: "The ethertype which the rule should match. If not set, it is derived from `ip_range`. " + utils.SupportedValuesDocumentation(etherTypeOptions),
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(etherTypeOptions...),
+				},
 			},
 			"icmp_parameters": schema.SingleNestedAttribute{
 				Description: "ICMP Parameters. These parameters should only be provided if the protocol is ICMP.",
@@ -693,10 +707,16 @@ func toCreatePayload(model *Model, icmpParameters *icmpParametersModel, portRang
 		return nil, fmt.Errorf("converting protocol: %w", err)
 	}
 
+	// The API defaults to IPv4, so the ether type of IPv6 ranges is set explicitly
+	etherType := conversion.StringValueToPointer(model.EtherType)
+	if etherType == nil && !utils.IsUndefined(model.IpRange) && iaasUtils.EtherType(model.IpRange.ValueString()) == iaasUtils.EtherTypeIPv6 {
+		etherType = sdkUtils.Ptr(iaasUtils.EtherTypeIPv6)
+	}
+
 	return &iaas.CreateSecurityGroupRulePayload{
 		Description:           conversion.StringValueToPointer(model.Description),
 		Direction:             conversion.StringValueToPointer(model.Direction),
-		Ethertype:             conversion.StringValueToPointer(model.EtherType),
+		Ethertype:             etherType,
 		IpRange:               conversion.StringValueToPointer(model.IpRange),
 		RemoteSecurityGroupId: conversion.StringValueToPointer(model.RemoteSecurityGroupId),
 		IcmpParameters:        payloadIcmpParameters,
@@ -249,6 +249,43 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"ipv6_ip_range",
+			&Model{
+				Direction: types.StringValue("ingress"),
+				IpRange:   types.StringValue("2001:db8::/64"),
+			},
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+				Ethertype: utils.Ptr("IPv6"),
+				IpRange:   utils.Ptr("2001:db8::/64"),
+			},
+			true,
+		},
+		{
+			"ipv4_ip_range",
+			&Model{
+				Direction: types.StringValue("ingress"),
+				IpRange:   types.StringValue("10.0.0.0/24"),
+			},
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+				IpRange:   utils.Ptr("10.0.0.0/24"),
+			},
+			true,
+		},
+		{
+			"configured_ether_type",
+			&Model{
+				Direction: types.StringValue("egress"),
+				EtherType: types.StringValue("IPv6"),
+			},
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("egress"),
+				Ethertype: utils.Ptr("IPv6"),
+			},
+			true,
+		},
 		{
 			"nil_model",
 			nil,
@@ -0,0 +1,309 @@
+package securitygrouprules
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &securityGroupRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &securityGroupRulesDataSource{}
+)
+
+type DataSourceModel struct {
+	Id              types.String `tfsdk:"id"` // needed by TF
+	ProjectId       types.String `tfsdk:"project_id"`
+	SecurityGroupId types.String `tfsdk:"security_group_id"`
+	Rules           types.List   `tfsdk:"rules"`
+}
+
+// Types corresponding to a single element of DataSourceModel.Rules
+var dataSourceRuleTypes = map[string]attr.Type{
+	"security_group_rule_id":   types.StringType,
+	"direction":                types.StringType,
+	"description":              types.StringType,
+	"ether_type":               types.StringType,
+	"ip_range":                 types.StringType,
+	"remote_security_group_id": types.StringType,
+	"protocol":                 types.ObjectType{AttrTypes: protocolTypes},
+	"port_range":               types.ObjectType{AttrTypes: portRangeTypes},
+	"icmp_parameters":          types.ObjectType{AttrTypes: icmpParametersTypes},
+}
+
+// NewSecurityGroupRulesDataSource is a helper function to simplify the provider implementation.
+func NewSecurityGroupRulesDataSource() datasource.DataSource {
+	return &securityGroupRulesDataSource{}
+}
+
+// securityGroupRulesDataSource is the data source implementation.
+type securityGroupRulesDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *securityGroupRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_group_rules"
+}
+
+func (d *securityGroupRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *securityGroupRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Security group rules datasource schema. Lists all rules of a security group. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal datasource ID. It is structured as \"`project_id`,`security_group_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the security group is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Description: "The security group ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "The rules of the security group, sorted by direction and ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"security_group_rule_id": schema.StringAttribute{
+							Description: "The security group rule ID.",
+							Computed:    true,
+						},
+						"direction": schema.StringAttribute{
+							Description: "The direction of the traffic which the rule should match.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The rule description.",
+							Computed:    true,
+						},
+						"ether_type": schema.StringAttribute{
+							Description: "The ethertype which the rule should match.",
+							Computed:    true,
+						},
+						"ip_range": schema.StringAttribute{
+							Description: "The remote IP range which the rule should match.",
+							Computed:    true,
+						},
+						"remote_security_group_id": schema.StringAttribute{
+							Description: "The remote security group which the rule should match.",
+							Computed:    true,
+						},
+						"protocol": schema.SingleNestedAttribute{
+							Description: "The internet protocol which the rule should match. Not set if the rule matches all protocols.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "The protocol name which the rule should match.",
+									Computed:    true,
+								},
+								"number": schema.Int64Attribute{
+									Description: "The protocol number which the rule should match.",
+									Computed:    true,
+								},
+							},
+						},
+						"port_range": schema.SingleNestedAttribute{
+							Description: "The range of ports.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"max": schema.Int64Attribute{
+									Description: "The maximum port number.",
+									Computed:    true,
+								},
+								"min": schema.Int64Attribute{
+									Description: "The minimum port number.",
+									Computed:    true,
+								},
+							},
+						},
+						"icmp_parameters": schema.SingleNestedAttribute{
+							Description: "ICMP Parameters.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"code": schema.Int64Attribute{
+									Description: "ICMP code.",
+									Computed:    true,
+								},
+								"type": schema.Int64Attribute{
+									Description: "ICMP type.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *securityGroupRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	securityGroupId := model.SecurityGroupId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+
+	rulesResp, err := d.client.ListSecurityGroupRulesExecute(ctx, projectId, securityGroupId)
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading security group rules",
+			fmt.Sprintf("Security group with ID %q does not exist in project %q.", securityGroupId, projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapDataSourceFields(rulesResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group rules", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Security group rules read")
+}
+
+func mapDataSourceFields(rulesResp *iaas.SecurityGroupRuleListResponse, model *DataSourceModel) error {
+	if rulesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.SecurityGroupId.ValueString())
+
+	rules := make([]iaas.SecurityGroupRule, len(rulesResp.GetItems()))
+	copy(rules, rulesResp.GetItems())
+	// Sort to get a stable result, the API doesn't guarantee any order
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].GetDirection() != rules[j].GetDirection() {
+			return rules[i].GetDirection() > rules[j].GetDirection() // ingress before egress
+		}
+		return rules[i].GetId() < rules[j].GetId()
+	})
+
+	rulesList := []attr.Value{}
+	for i := range rules {
+		rule := rules[i]
+
+		protocolTF := types.ObjectNull(protocolTypes)
+		if rule.Protocol != nil && (rule.Protocol.Name != nil || rule.Protocol.Number != nil) {
+			object, diags := types.ObjectValue(protocolTypes, map[string]attr.Value{
+				"name":   types.StringPointerValue(rule.Protocol.Name),
+				"number": types.Int64PointerValue(rule.Protocol.Number),
+			})
+			if diags.HasError() {
+				return fmt.Errorf("mapping protocol of index %d: %w", i, core.DiagsToError(diags))
+			}
+			protocolTF = object
+		}
+
+		portRangeTF := types.ObjectNull(portRangeTypes)
+		if rule.PortRange != nil {
+			object, diags := types.ObjectValue(portRangeTypes, map[string]attr.Value{
+				"max": types.Int64PointerValue(rule.PortRange.Max),
+				"min": types.Int64PointerValue(rule.PortRange.Min),
+			})
+			if diags.HasError() {
+				return fmt.Errorf("mapping port range of index %d: %w", i, core.DiagsToError(diags))
+			}
+			portRangeTF = object
+		}
+
+		icmpParametersTF := types.ObjectNull(icmpParametersTypes)
+		if rule.IcmpParameters != nil {
+			object, diags := types.ObjectValue(icmpParametersTypes, map[string]attr.Value{
+				"code": types.Int64PointerValue(rule.IcmpParameters.Code),
+				"type": types.Int64PointerValue(rule.IcmpParameters.Type),
+			})
+			if diags.HasError() {
+				return fmt.Errorf("mapping icmp parameters of index %d: %w", i, core.DiagsToError(diags))
+			}
+			icmpParametersTF = object
+		}
+
+		ruleTF, diags := types.ObjectValue(dataSourceRuleTypes, map[string]attr.Value{
+			"security_group_rule_id":   types.StringPointerValue(rule.Id),
+			"direction":                types.StringPointerValue(rule.Direction),
+			"description":              types.StringPointerValue(rule.Description),
+			"ether_type":               types.StringPointerValue(rule.Ethertype),
+			"ip_range":                 types.StringPointerValue(rule.IpRange),
+			"remote_security_group_id": types.StringPointerValue(rule.RemoteSecurityGroupId),
+			"protocol":                 protocolTF,
+			"port_range":               portRangeTF,
+			"icmp_parameters":          icmpParametersTF,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		rulesList = append(rulesList, ruleTF)
+	}
+
+	rulesTF, diags := types.ListValue(types.ObjectType{AttrTypes: dataSourceRuleTypes}, rulesList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Rules = rulesTF
+	return nil
+}
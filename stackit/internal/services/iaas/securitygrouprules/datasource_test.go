@@ -0,0 +1,159 @@
+package securitygrouprules
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.SecurityGroupRuleListResponse
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			DataSourceModel{
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+			},
+			&iaas.SecurityGroupRuleListResponse{},
+			DataSourceModel{
+				Id:              types.StringValue("pid,sgid"),
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           types.ListValueMust(types.ObjectType{AttrTypes: dataSourceRuleTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"simple_values_sorted",
+			DataSourceModel{
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+			},
+			&iaas.SecurityGroupRuleListResponse{
+				Items: &[]iaas.SecurityGroupRule{
+					{
+						Id:        utils.Ptr("rid-1"),
+						Direction: utils.Ptr("egress"),
+						Ethertype: utils.Ptr("IPv4"),
+					},
+					{
+						Id:                    utils.Ptr("rid-3"),
+						Direction:             utils.Ptr("ingress"),
+						Description:           utils.Ptr("icmp"),
+						Ethertype:             utils.Ptr("IPv4"),
+						RemoteSecurityGroupId: utils.Ptr("remote-sgid"),
+						Protocol: &iaas.Protocol{
+							Name:   utils.Ptr("icmp"),
+							Number: utils.Ptr(int64(1)),
+						},
+						IcmpParameters: &iaas.ICMPParameters{
+							Code: utils.Ptr(int64(0)),
+							Type: utils.Ptr(int64(8)),
+						},
+					},
+					{
+						Id:        utils.Ptr("rid-2"),
+						Direction: utils.Ptr("ingress"),
+						Ethertype: utils.Ptr("IPv6"),
+						IpRange:   utils.Ptr("::/0"),
+						Protocol: &iaas.Protocol{
+							Name:   utils.Ptr("tcp"),
+							Number: utils.Ptr(int64(6)),
+						},
+						PortRange: &iaas.PortRange{
+							Max: utils.Ptr(int64(443)),
+							Min: utils.Ptr(int64(443)),
+						},
+					},
+				},
+			},
+			DataSourceModel{
+				Id:              types.StringValue("pid,sgid"),
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules: types.ListValueMust(types.ObjectType{AttrTypes: dataSourceRuleTypes}, []attr.Value{
+					types.ObjectValueMust(dataSourceRuleTypes, map[string]attr.Value{
+						"security_group_rule_id":   types.StringValue("rid-2"),
+						"direction":                types.StringValue("ingress"),
+						"description":              types.StringNull(),
+						"ether_type":               types.StringValue("IPv6"),
+						"ip_range":                 types.StringValue("::/0"),
+						"remote_security_group_id": types.StringNull(),
+						"protocol": types.ObjectValueMust(protocolTypes, map[string]attr.Value{
+							"name":   types.StringValue("tcp"),
+							"number": types.Int64Value(6),
+						}),
+						"port_range": types.ObjectValueMust(portRangeTypes, map[string]attr.Value{
+							"max": types.Int64Value(443),
+							"min": types.Int64Value(443),
+						}),
+						"icmp_parameters": types.ObjectNull(icmpParametersTypes),
+					}),
+					types.ObjectValueMust(dataSourceRuleTypes, map[string]attr.Value{
+						"security_group_rule_id":   types.StringValue("rid-3"),
+						"direction":                types.StringValue("ingress"),
+						"description":              types.StringValue("icmp"),
+						"ether_type":               types.StringValue("IPv4"),
+						"ip_range":                 types.StringNull(),
+						"remote_security_group_id": types.StringValue("remote-sgid"),
+						"protocol": types.ObjectValueMust(protocolTypes, map[string]attr.Value{
+							"name":   types.StringValue("icmp"),
+							"number": types.Int64Value(1),
+						}),
+						"port_range": types.ObjectNull(portRangeTypes),
+						"icmp_parameters": types.ObjectValueMust(icmpParametersTypes, map[string]attr.Value{
+							"code": types.Int64Value(0),
+							"type": types.Int64Value(8),
+						}),
+					}),
+					types.ObjectValueMust(dataSourceRuleTypes, map[string]attr.Value{
+						"security_group_rule_id":   types.StringValue("rid-1"),
+						"direction":                types.StringValue("egress"),
+						"description":              types.StringNull(),
+						"ether_type":               types.StringValue("IPv4"),
+						"ip_range":                 types.StringNull(),
+						"remote_security_group_id": types.StringNull(),
+						"protocol":                 types.ObjectNull(protocolTypes),
+						"port_range":               types.ObjectNull(portRangeTypes),
+						"icmp_parameters":          types.ObjectNull(icmpParametersTypes),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			DataSourceModel{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,866 @@
+package securitygrouprules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_                       resource.Resource                   = &securityGroupRulesResource{}
+	_                       resource.ResourceWithConfigure      = &securityGroupRulesResource{}
+	_                       resource.ResourceWithImportState    = &securityGroupRulesResource{}
+	_                       resource.ResourceWithValidateConfig = &securityGroupRulesResource{}
+	icmpProtocols                                               = []string{"icmp", "ipv6-icmp"}
+	directionOptions                                            = []string{"ingress", "egress"}
+	etherTypeOptions                                            = []string{iaasUtils.EtherTypeIPv4, iaasUtils.EtherTypeIPv6}
+	protocolsPossibleValues                                     = []string{
+		"ah", "dccp", "egp", "esp", "gre", "icmp", "igmp", "ipip", "ipv6-encap", "ipv6-frag", "ipv6-icmp",
+		"ipv6-nonxt", "ipv6-opts", "ipv6-route", "ospf", "pgm", "rsvp", "sctp", "tcp", "udp", "udplite", "vrrp",
+	}
+)
+
+type Model struct {
+	Id              types.String `tfsdk:"id"` // needed by TF
+	ProjectId       types.String `tfsdk:"project_id"`
+	SecurityGroupId types.String `tfsdk:"security_group_id"`
+	Rules           types.Set    `tfsdk:"rules"`
+}
+
+// Struct corresponding to a single element of Model.Rules
+type ruleModel struct {
+	Direction             types.String `tfsdk:"direction"`
+	Description           types.String `tfsdk:"description"`
+	EtherType             types.String `tfsdk:"ether_type"`
+	IpRange               types.String `tfsdk:"ip_range"`
+	RemoteSecurityGroupId types.String `tfsdk:"remote_security_group_id"`
+	Protocol              types.Object `tfsdk:"protocol"`
+	PortRange             types.Object `tfsdk:"port_range"`
+	IcmpParameters        types.Object `tfsdk:"icmp_parameters"`
+}
+
+type protocolModel struct {
+	Name   types.String `tfsdk:"name"`
+	Number types.Int64  `tfsdk:"number"`
+}
+
+type portRangeModel struct {
+	Max types.Int64 `tfsdk:"max"`
+	Min types.Int64 `tfsdk:"min"`
+}
+
+type icmpParametersModel struct {
+	Code types.Int64 `tfsdk:"code"`
+	Type types.Int64 `tfsdk:"type"`
+}
+
+// Types corresponding to protocolModel
+var protocolTypes = map[string]attr.Type{
+	"name":   basetypes.StringType{},
+	"number": basetypes.Int64Type{},
+}
+
+// Types corresponding to portRangeModel
+var portRangeTypes = map[string]attr.Type{
+	"max": basetypes.Int64Type{},
+	"min": basetypes.Int64Type{},
+}
+
+// Types corresponding to icmpParametersModel
+var icmpParametersTypes = map[string]attr.Type{
+	"code": basetypes.Int64Type{},
+	"type": basetypes.Int64Type{},
+}
+
+// Types corresponding to ruleModel
+var ruleTypes = map[string]attr.Type{
+	"direction":                basetypes.StringType{},
+	"description":              basetypes.StringType{},
+	"ether_type":               basetypes.StringType{},
+	"ip_range":                 basetypes.StringType{},
+	"remote_security_group_id": basetypes.StringType{},
+	"protocol":                 basetypes.ObjectType{AttrTypes: protocolTypes},
+	"port_range":               basetypes.ObjectType{AttrTypes: portRangeTypes},
+	"icmp_parameters":          basetypes.ObjectType{AttrTypes: icmpParametersTypes},
+}
+
+// NewSecurityGroupRulesResource is a helper function to simplify the provider implementation.
+func NewSecurityGroupRulesResource() resource.Resource {
+	return &securityGroupRulesResource{}
+}
+
+// securityGroupRulesResource is the resource implementation.
+type securityGroupRulesResource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *securityGroupRulesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_group_rules"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *securityGroupRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// ValidateConfig checks that port ranges and ICMP parameters are only used with matching protocols.
+func (r *securityGroupRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if model.Rules.IsNull() || model.Rules.IsUnknown() {
+		return
+	}
+
+	rules := []ruleModel{}
+	resp.Diagnostics.Append(model.Rules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, rule := range rules {
+		// The ether type must match the IP family of the remote IP range
+		if !utils.IsUndefined(rule.EtherType) && !utils.IsUndefined(rule.IpRange) && iaasUtils.EtherType(rule.IpRange.ValueString()) != rule.EtherType.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules"),
+				"Conflicting attribute configuration",
+				fmt.Sprintf("`ip_range` %q doesn't match `ether_type` %q", rule.IpRange.ValueString(), rule.EtherType.ValueString()),
+			)
+		}
+		if rule.Protocol.IsNull() || rule.Protocol.IsUnknown() {
+			continue
+		}
+		protocol := &protocolModel{}
+		resp.Diagnostics.Append(rule.Protocol.As(ctx, protocol, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if protocol.Name.IsNull() || protocol.Name.IsUnknown() {
+			continue
+		}
+
+		if slices.Contains(icmpProtocols, protocol.Name.ValueString()) {
+			if !rule.PortRange.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("rules"),
+					"Conflicting attribute configuration",
+					"`port_range` attribute can't be provided if `protocol.name` is set to `icmp` or `ipv6-icmp`",
+				)
+			}
+		} else if !rule.IcmpParameters.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules"),
+				"Conflicting attribute configuration",
+				"`icmp_parameters` attribute can't be provided if `protocol.name` is not `icmp` or `ipv6-icmp`",
+			)
+		}
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *securityGroupRulesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Security group rules resource schema. Manages the complete set of rules of a security group: " +
+		"rules which are not part of the configuration, including rules created outside of Terraform, are deleted. " +
+		"Must not be combined with `stackit_security_group_rule` resources for the same security group. " +
+		"Must have a `region` specified in the provider configuration."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`security_group_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the security group is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Description: "The security group ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"rules": schema.SetNestedAttribute{
+				Description: "The rules of the security group. Rules can't be changed, a changed rule is deleted and created again.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"direction": schema.StringAttribute{
+							Description: "The direction of the traffic which the rule should match. " + utils.FormatPossibleValues(directionOptions...),
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(directionOptions...),
+							},
+						},
+						"description": schema.StringAttribute{
+							Description: "The rule description.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(127),
+							},
+						},
+						"ether_type": schema.StringAttribute{
+							Description: fmt.Sprintf("The ethertype which the rule should match. Defaults to `%s`, or `%s` if `ip_range` is an IPv6 range. %s", iaasUtils.EtherTypeIPv4, iaasUtils.EtherTypeIPv6, utils.FormatPossibleValues(etherTypeOptions...)),
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(etherTypeOptions...),
+							},
+						},
+						"ip_range": schema.StringAttribute{
+							Description: "The remote IP range which the rule should match.",
+							Optional:    true,
+							Validators: []validator.String{
+								validate.CIDR(),
+							},
+						},
+						"remote_security_group_id": schema.StringAttribute{
+							Description: "The remote security group which the rule should match.",
+							Optional:    true,
+							Validators: []validator.String{
+								validate.UUID(),
+								validate.NoSeparator(),
+							},
+						},
+						"protocol": schema.SingleNestedAttribute{
+							Description: "The internet protocol which the rule should match. If not set, the rule matches all protocols.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: fmt.Sprintf("The protocol name which the rule should match. Either `name` or `number` must be provided. %s", utils.FormatPossibleValues(protocolsPossibleValues...)),
+									Optional:    true,
+									Validators: []validator.String{
+										stringvalidator.ExactlyOneOf(
+											path.MatchRelative().AtParent().AtName("number"),
+										),
+									},
+								},
+								"number": schema.Int64Attribute{
+									Description: "The protocol number which the rule should match. Either `name` or `number` must be provided.",
+									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(255),
+									},
+								},
+							},
+						},
+						"port_range": schema.SingleNestedAttribute{
+							Description: "The range of ports. This should only be provided if the protocol is not ICMP.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"max": schema.Int64Attribute{
+									Description: "The maximum port number. Should be greater or equal to the minimum.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(65535),
+									},
+								},
+								"min": schema.Int64Attribute{
+									Description: "The minimum port number. Should be less or equal to the maximum.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(65535),
+									},
+								},
+							},
+						},
+						"icmp_parameters": schema.SingleNestedAttribute{
+							Description: "ICMP Parameters. These parameters should only be provided if the protocol is ICMP.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"code": schema.Int64Attribute{
+									Description: "ICMP code. Can be set if the protocol is ICMP.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(255),
+									},
+								},
+								"type": schema.Int64Attribute{
+									Description: "ICMP type. Can be set if the protocol is ICMP.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(255),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *securityGroupRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	securityGroupId := model.SecurityGroupId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+
+	err := r.reconcile(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating security group rules", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Security group rules created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *securityGroupRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	securityGroupId := model.SecurityGroupId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+
+	rulesResp, err := r.client.ListSecurityGroupRulesExecute(ctx, projectId, securityGroupId)
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group rules", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(ctx, rulesResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group rules", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Security group rules read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *securityGroupRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	securityGroupId := model.SecurityGroupId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+
+	err := r.reconcile(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating security group rules", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Security group rules updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// Only the rules which are part of the state are deleted.
+func (r *securityGroupRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	securityGroupId := model.SecurityGroupId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+
+	rules, err := toRules(ctx, model.Rules)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting security group rules", fmt.Sprintf("Processing state: %v", err))
+		return
+	}
+	rulesResp, err := r.client.ListSecurityGroupRulesExecute(ctx, projectId, securityGroupId)
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Security group already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting security group rules", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Rules of the state which still exist are deleted, other rules are kept
+	_, unmatched := matchRules(rules, rulesResp.GetItems())
+	managed := withoutRules(rulesResp.GetItems(), unmatched)
+	for _, rule := range managed {
+		err := r.deleteRule(ctx, projectId, securityGroupId, rule.GetId())
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting security group rules", err.Error())
+			return
+		}
+	}
+	tflog.Info(ctx, "Security group rules deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,security_group_id
+func (r *securityGroupRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing security group rules",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[security_group_id]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	projectId := idParts[0]
+	securityGroupId := idParts[1]
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("security_group_id"), securityGroupId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rules"), types.SetNull(types.ObjectType{AttrTypes: ruleTypes}))...)
+	tflog.Info(ctx, "Security group rules state imported")
+}
+
+// reconcile creates the rules of the model which don't exist yet and deletes all other rules of the security group
+func (r *securityGroupRulesResource) reconcile(ctx context.Context, model *Model) error {
+	projectId := model.ProjectId.ValueString()
+	securityGroupId := model.SecurityGroupId.ValueString()
+
+	rules, err := toRules(ctx, model.Rules)
+	if err != nil {
+		return fmt.Errorf("processing configuration: %w", err)
+	}
+	rulesResp, err := r.client.ListSecurityGroupRulesExecute(ctx, projectId, securityGroupId)
+	if err != nil {
+		return fmt.Errorf("listing rules: %w", err)
+	}
+
+	missing, unmatched := matchRules(rules, rulesResp.GetItems())
+	for _, rule := range unmatched {
+		tflog.Info(ctx, "Deleting security group rule which is not part of the configuration", map[string]any{"security_group_rule_id": rule.GetId()})
+		err := r.deleteRule(ctx, projectId, securityGroupId, rule.GetId())
+		if err != nil {
+			return err
+		}
+	}
+	for _, rule := range missing {
+		payload, err := toCreatePayload(rule)
+		if err != nil {
+			return fmt.Errorf("creating API payload: %w", err)
+		}
+		created, err := r.client.CreateSecurityGroupRule(ctx, projectId, securityGroupId).CreateSecurityGroupRulePayload(*payload).Execute()
+		if err != nil {
+			return fmt.Errorf("creating rule: %w", err)
+		}
+		tflog.Info(ctx, "Security group rule created", map[string]any{"security_group_rule_id": created.GetId()})
+	}
+
+	model.Id = utils.BuildInternalTerraformId(projectId, securityGroupId)
+	return nil
+}
+
+func (r *securityGroupRulesResource) deleteRule(ctx context.Context, projectId, securityGroupId, securityGroupRuleId string) error {
+	err := r.client.DeleteSecurityGroupRule(ctx, projectId, securityGroupId, securityGroupRuleId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("deleting rule %q: %w", securityGroupRuleId, err)
+	}
+	return nil
+}
+
+// mapFields maps the rules of the security group to the model. Rules which match a rule of the current state keep
+// the representation of the state, all other rules, e.g. created outside of Terraform, are added as returned by the API.
+func mapFields(ctx context.Context, rulesResp *iaas.SecurityGroupRuleListResponse, model *Model) error {
+	if rulesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.SecurityGroupId.ValueString())
+
+	stateRules, err := toRules(ctx, model.Rules)
+	if err != nil {
+		return fmt.Errorf("processing state: %w", err)
+	}
+	stateValues := []attr.Value{}
+	if !model.Rules.IsNull() && !model.Rules.IsUnknown() {
+		stateValues = model.Rules.Elements()
+	}
+
+	rulesList := []attr.Value{}
+	remaining := rulesResp.GetItems()
+	for i, rule := range stateRules {
+		index := slices.IndexFunc(remaining, func(actual iaas.SecurityGroupRule) bool {
+			return rule.matches(&actual)
+		})
+		if index < 0 {
+			// the rule was deleted outside of Terraform
+			continue
+		}
+		remaining = slices.Delete(slices.Clone(remaining), index, index+1)
+		rulesList = append(rulesList, stateValues[i])
+	}
+	for i := range remaining {
+		ruleTF, err := toRuleValue(&remaining[i])
+		if err != nil {
+			return fmt.Errorf("mapping rule %q: %w", remaining[i].GetId(), err)
+		}
+		rulesList = append(rulesList, ruleTF)
+	}
+
+	rulesTF, diags := types.SetValue(types.ObjectType{AttrTypes: ruleTypes}, rulesList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Rules = rulesTF
+	return nil
+}
+
+// rule is the configuration of a single rule
+type rule struct {
+	direction             *string
+	description           *string
+	etherType             *string
+	ipRange               *string
+	remoteSecurityGroupId *string
+	protocolName          *string
+	protocolNumber        *int64
+	portRange             *iaas.PortRange
+	icmpParameters        *iaas.ICMPParameters
+}
+
+// matches reports whether the rule returned by the API is equal to the configured rule
+func (r *rule) matches(actual *iaas.SecurityGroupRule) bool {
+	if actual == nil {
+		return false
+	}
+	if !equalString(r.direction, actual.Direction, "") ||
+		!equalString(r.description, actual.Description, "") ||
+		!equalString(r.etherType, actual.Ethertype, iaasUtils.EtherTypeIPv4) ||
+		!equalString(r.ipRange, actual.IpRange, "") ||
+		!equalString(r.remoteSecurityGroupId, actual.RemoteSecurityGroupId, "") {
+		return false
+	}
+
+	switch {
+	case r.protocolName != nil:
+		if actual.Protocol == nil || actual.Protocol.GetName() != *r.protocolName {
+			return false
+		}
+	case r.protocolNumber != nil:
+		if actual.Protocol == nil || actual.Protocol.Number == nil || *actual.Protocol.Number != *r.protocolNumber {
+			return false
+		}
+	default:
+		if actual.Protocol != nil && (actual.Protocol.Name != nil || actual.Protocol.Number != nil) {
+			return false
+		}
+	}
+
+	if (r.portRange == nil) != (actual.PortRange == nil) {
+		return false
+	}
+	if r.portRange != nil && (r.portRange.GetMin() != actual.PortRange.GetMin() || r.portRange.GetMax() != actual.PortRange.GetMax()) {
+		return false
+	}
+	if (r.icmpParameters == nil) != (actual.IcmpParameters == nil) {
+		return false
+	}
+	if r.icmpParameters != nil && (r.icmpParameters.GetCode() != actual.IcmpParameters.GetCode() || r.icmpParameters.GetType() != actual.IcmpParameters.GetType()) {
+		return false
+	}
+	return true
+}
+
+// equalString compares an optional configured value with the value returned by the API, unset values are replaced by the default
+func equalString(configured, actual *string, defaultValue string) bool {
+	configuredValue := defaultValue
+	if configured != nil {
+		configuredValue = *configured
+	}
+	actualValue := defaultValue
+	if actual != nil && *actual != "" {
+		actualValue = *actual
+	}
+	return configuredValue == actualValue
+}
+
+// matchRules returns the configured rules which don't exist and the existing rules which aren't configured.
+// Equal rules are matched one by one, so duplicates are detected.
+func matchRules(rules []*rule, actual []iaas.SecurityGroupRule) (missing []*rule, unmatched []iaas.SecurityGroupRule) {
+	used := make([]bool, len(actual))
+	for _, r := range rules {
+		found := false
+		for i := range actual {
+			if !used[i] && r.matches(&actual[i]) {
+				used[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, r)
+		}
+	}
+	for i := range actual {
+		if !used[i] {
+			unmatched = append(unmatched, actual[i])
+		}
+	}
+	return missing, unmatched
+}
+
+func withoutRules(rules, remove []iaas.SecurityGroupRule) []iaas.SecurityGroupRule {
+	result := []iaas.SecurityGroupRule{}
+	for _, rule := range rules {
+		if !slices.ContainsFunc(remove, func(r iaas.SecurityGroupRule) bool { return r.GetId() == rule.GetId() }) {
+			result = append(result, rule)
+		}
+	}
+	return result
+}
+
+func toRules(ctx context.Context, rulesTF types.Set) ([]*rule, error) {
+	if rulesTF.IsNull() || rulesTF.IsUnknown() {
+		return nil, nil
+	}
+	ruleModels := []ruleModel{}
+	diags := rulesTF.ElementsAs(ctx, &ruleModels, false)
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	rules := []*rule{}
+	for i := range ruleModels {
+		ruleModel := ruleModels[i]
+		r := &rule{
+			direction:             conversion.StringValueToPointer(ruleModel.Direction),
+			description:           conversion.StringValueToPointer(ruleModel.Description),
+			etherType:             conversion.StringValueToPointer(ruleModel.EtherType),
+			ipRange:               conversion.StringValueToPointer(ruleModel.IpRange),
+			remoteSecurityGroupId: conversion.StringValueToPointer(ruleModel.RemoteSecurityGroupId),
+		}
+		// The API defaults to IPv4, so the ether type of IPv6 ranges is set explicitly
+		if r.etherType == nil && r.ipRange != nil && iaasUtils.EtherType(*r.ipRange) == iaasUtils.EtherTypeIPv6 {
+			r.etherType = sdkUtils.Ptr(iaasUtils.EtherTypeIPv6)
+		}
+		if !(ruleModel.Protocol.IsNull() || ruleModel.Protocol.IsUnknown()) {
+			protocol := &protocolModel{}
+			diags := ruleModel.Protocol.As(ctx, protocol, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, fmt.Errorf("converting protocol: %w", core.DiagsToError(diags))
+			}
+			r.protocolName = conversion.StringValueToPointer(protocol.Name)
+			r.protocolNumber = conversion.Int64ValueToPointer(protocol.Number)
+		}
+		if !(ruleModel.PortRange.IsNull() || ruleModel.PortRange.IsUnknown()) {
+			portRange := &portRangeModel{}
+			diags := ruleModel.PortRange.As(ctx, portRange, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, fmt.Errorf("converting port range: %w", core.DiagsToError(diags))
+			}
+			r.portRange = &iaas.PortRange{
+				Max: conversion.Int64ValueToPointer(portRange.Max),
+				Min: conversion.Int64ValueToPointer(portRange.Min),
+			}
+		}
+		if !(ruleModel.IcmpParameters.IsNull() || ruleModel.IcmpParameters.IsUnknown()) {
+			icmpParameters := &icmpParametersModel{}
+			diags := ruleModel.IcmpParameters.As(ctx, icmpParameters, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, fmt.Errorf("converting icmp parameters: %w", core.DiagsToError(diags))
+			}
+			r.icmpParameters = &iaas.ICMPParameters{
+				Code: conversion.Int64ValueToPointer(icmpParameters.Code),
+				Type: conversion.Int64ValueToPointer(icmpParameters.Type),
+			}
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// toRuleValue maps a rule returned by the API to an element of Model.Rules
+func toRuleValue(securityGroupRule *iaas.SecurityGroupRule) (attr.Value, error) {
+	protocolTF := types.ObjectNull(protocolTypes)
+	if securityGroupRule.Protocol != nil && (securityGroupRule.Protocol.Name != nil || securityGroupRule.Protocol.Number != nil) {
+		values := map[string]attr.Value{
+			"name":   types.StringPointerValue(securityGroupRule.Protocol.Name),
+			"number": types.Int64Null(),
+		}
+		if securityGroupRule.Protocol.Name == nil {
+			values["number"] = types.Int64PointerValue(securityGroupRule.Protocol.Number)
+		}
+		object, diags := types.ObjectValue(protocolTypes, values)
+		if diags.HasError() {
+			return nil, fmt.Errorf("create protocol object: %w", core.DiagsToError(diags))
+		}
+		protocolTF = object
+	}
+
+	portRangeTF := types.ObjectNull(portRangeTypes)
+	if securityGroupRule.PortRange != nil {
+		object, diags := types.ObjectValue(portRangeTypes, map[string]attr.Value{
+			"max": types.Int64PointerValue(securityGroupRule.PortRange.Max),
+			"min": types.Int64PointerValue(securityGroupRule.PortRange.Min),
+		})
+		if diags.HasError() {
+			return nil, fmt.Errorf("create port range object: %w", core.DiagsToError(diags))
+		}
+		portRangeTF = object
+	}
+
+	icmpParametersTF := types.ObjectNull(icmpParametersTypes)
+	if securityGroupRule.IcmpParameters != nil {
+		object, diags := types.ObjectValue(icmpParametersTypes, map[string]attr.Value{
+			"code": types.Int64PointerValue(securityGroupRule.IcmpParameters.Code),
+			"type": types.Int64PointerValue(securityGroupRule.IcmpParameters.Type),
+		})
+		if diags.HasError() {
+			return nil, fmt.Errorf("create icmp parameters object: %w", core.DiagsToError(diags))
+		}
+		icmpParametersTF = object
+	}
+
+	description := types.StringNull()
+	if securityGroupRule.GetDescription() != "" {
+		description = types.StringValue(securityGroupRule.GetDescription())
+	}
+
+	ruleTF, diags := types.ObjectValue(ruleTypes, map[string]attr.Value{
+		"direction":                types.StringPointerValue(securityGroupRule.Direction),
+		"description":              description,
+		"ether_type":               types.StringPointerValue(securityGroupRule.Ethertype),
+		"ip_range":                 types.StringPointerValue(securityGroupRule.IpRange),
+		"remote_security_group_id": types.StringPointerValue(securityGroupRule.RemoteSecurityGroupId),
+		"protocol":                 protocolTF,
+		"port_range":               portRangeTF,
+		"icmp_parameters":          icmpParametersTF,
+	})
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+	return ruleTF, nil
+}
+
+func toCreatePayload(r *rule) (*iaas.CreateSecurityGroupRulePayload, error) {
+	if r == nil {
+		return nil, fmt.Errorf("nil rule")
+	}
+
+	var protocol *iaas.CreateProtocol
+	if r.protocolName != nil || r.protocolNumber != nil {
+		protocol = &iaas.CreateProtocol{
+			String: r.protocolName,
+			Int64:  r.protocolNumber,
+		}
+	}
+
+	return &iaas.CreateSecurityGroupRulePayload{
+		Description:           r.description,
+		Direction:             r.direction,
+		Ethertype:             r.etherType,
+		IpRange:               r.ipRange,
+		RemoteSecurityGroupId: r.remoteSecurityGroupId,
+		IcmpParameters:        r.icmpParameters,
+		PortRange:             r.portRange,
+		Protocol:              protocol,
+	}, nil
+}
@@ -0,0 +1,491 @@
+package securitygrouprules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func ruleValue(values map[string]attr.Value) attr.Value {
+	rule := map[string]attr.Value{
+		"direction":                types.StringNull(),
+		"description":              types.StringNull(),
+		"ether_type":               types.StringNull(),
+		"ip_range":                 types.StringNull(),
+		"remote_security_group_id": types.StringNull(),
+		"protocol":                 types.ObjectNull(protocolTypes),
+		"port_range":               types.ObjectNull(portRangeTypes),
+		"icmp_parameters":          types.ObjectNull(icmpParametersTypes),
+	}
+	for k, v := range values {
+		rule[k] = v
+	}
+	return types.ObjectValueMust(ruleTypes, rule)
+}
+
+func rulesValue(rules ...attr.Value) types.Set {
+	return types.SetValueMust(types.ObjectType{AttrTypes: ruleTypes}, rules)
+}
+
+var (
+	sshRule = ruleValue(map[string]attr.Value{
+		"direction": types.StringValue("ingress"),
+		"ip_range":  types.StringValue("0.0.0.0/0"),
+		"protocol": types.ObjectValueMust(protocolTypes, map[string]attr.Value{
+			"name":   types.StringValue("tcp"),
+			"number": types.Int64Null(),
+		}),
+		"port_range": types.ObjectValueMust(portRangeTypes, map[string]attr.Value{
+			"max": types.Int64Value(22),
+			"min": types.Int64Value(22),
+		}),
+	})
+	sshRuleAPI = iaas.SecurityGroupRule{
+		Id:        utils.Ptr("ssh"),
+		Direction: utils.Ptr("ingress"),
+		Ethertype: utils.Ptr("IPv4"),
+		IpRange:   utils.Ptr("0.0.0.0/0"),
+		Protocol: &iaas.Protocol{
+			Name:   utils.Ptr("tcp"),
+			Number: utils.Ptr(int64(6)),
+		},
+		PortRange: &iaas.PortRange{
+			Max: utils.Ptr(int64(22)),
+			Min: utils.Ptr(int64(22)),
+		},
+	}
+	egressRuleAPI = iaas.SecurityGroupRule{
+		Id:          utils.Ptr("egress"),
+		Direction:   utils.Ptr("egress"),
+		Ethertype:   utils.Ptr("IPv6"),
+		Description: utils.Ptr("default"),
+	}
+	egressRule = ruleValue(map[string]attr.Value{
+		"direction":   types.StringValue("egress"),
+		"description": types.StringValue("default"),
+		"ether_type":  types.StringValue("IPv6"),
+	})
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       Model
+		input       *iaas.SecurityGroupRuleListResponse
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"rules_unchanged",
+			Model{
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           rulesValue(sshRule),
+			},
+			&iaas.SecurityGroupRuleListResponse{
+				Items: &[]iaas.SecurityGroupRule{sshRuleAPI},
+			},
+			Model{
+				Id:              types.StringValue("pid,sgid"),
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           rulesValue(sshRule),
+			},
+			true,
+		},
+		{
+			"rule_added_outside_of_terraform",
+			Model{
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           rulesValue(sshRule),
+			},
+			&iaas.SecurityGroupRuleListResponse{
+				Items: &[]iaas.SecurityGroupRule{egressRuleAPI, sshRuleAPI},
+			},
+			Model{
+				Id:              types.StringValue("pid,sgid"),
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           rulesValue(sshRule, egressRule),
+			},
+			true,
+		},
+		{
+			"rule_deleted_outside_of_terraform",
+			Model{
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           rulesValue(sshRule, egressRule),
+			},
+			&iaas.SecurityGroupRuleListResponse{
+				Items: &[]iaas.SecurityGroupRule{egressRuleAPI},
+			},
+			Model{
+				Id:              types.StringValue("pid,sgid"),
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           rulesValue(egressRule),
+			},
+			true,
+		},
+		{
+			"ipv6_rule_without_ether_type",
+			Model{
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules: rulesValue(ruleValue(map[string]attr.Value{
+					"direction": types.StringValue("ingress"),
+					"ip_range":  types.StringValue("2001:db8::/64"),
+				})),
+			},
+			&iaas.SecurityGroupRuleListResponse{
+				Items: &[]iaas.SecurityGroupRule{
+					{
+						Id:        utils.Ptr("rid"),
+						Direction: utils.Ptr("ingress"),
+						Ethertype: utils.Ptr("IPv6"),
+						IpRange:   utils.Ptr("2001:db8::/64"),
+					},
+				},
+			},
+			Model{
+				Id:              types.StringValue("pid,sgid"),
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules: rulesValue(ruleValue(map[string]attr.Value{
+					"direction": types.StringValue("ingress"),
+					"ip_range":  types.StringValue("2001:db8::/64"),
+				})),
+			},
+			true,
+		},
+		{
+			"imported",
+			Model{
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           types.SetNull(types.ObjectType{AttrTypes: ruleTypes}),
+			},
+			&iaas.SecurityGroupRuleListResponse{
+				Items: &[]iaas.SecurityGroupRule{
+					{
+						Id:        utils.Ptr("rid"),
+						Direction: utils.Ptr("ingress"),
+						Ethertype: utils.Ptr("IPv4"),
+						Protocol: &iaas.Protocol{
+							Number: utils.Ptr(int64(112)),
+						},
+						IcmpParameters: nil,
+					},
+				},
+			},
+			Model{
+				Id:              types.StringValue("pid,sgid"),
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules: rulesValue(ruleValue(map[string]attr.Value{
+					"direction":  types.StringValue("ingress"),
+					"ether_type": types.StringValue("IPv4"),
+					"protocol": types.ObjectValueMust(protocolTypes, map[string]attr.Value{
+						"name":   types.StringNull(),
+						"number": types.Int64Value(112),
+					}),
+				})),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			Model{},
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		description string
+		rule        *rule
+		actual      *iaas.SecurityGroupRule
+		expected    bool
+	}{
+		{
+			"equal",
+			&rule{
+				direction:    utils.Ptr("ingress"),
+				ipRange:      utils.Ptr("0.0.0.0/0"),
+				protocolName: utils.Ptr("tcp"),
+				portRange:    &iaas.PortRange{Max: utils.Ptr(int64(22)), Min: utils.Ptr(int64(22))},
+			},
+			&sshRuleAPI,
+			true,
+		},
+		{
+			"protocol_number",
+			&rule{
+				direction:      utils.Ptr("ingress"),
+				ipRange:        utils.Ptr("0.0.0.0/0"),
+				protocolNumber: utils.Ptr(int64(6)),
+				portRange:      &iaas.PortRange{Max: utils.Ptr(int64(22)), Min: utils.Ptr(int64(22))},
+			},
+			&sshRuleAPI,
+			true,
+		},
+		{
+			"different_port_range",
+			&rule{
+				direction:    utils.Ptr("ingress"),
+				ipRange:      utils.Ptr("0.0.0.0/0"),
+				protocolName: utils.Ptr("tcp"),
+				portRange:    &iaas.PortRange{Max: utils.Ptr(int64(23)), Min: utils.Ptr(int64(22))},
+			},
+			&sshRuleAPI,
+			false,
+		},
+		{
+			"missing_port_range",
+			&rule{
+				direction:    utils.Ptr("ingress"),
+				ipRange:      utils.Ptr("0.0.0.0/0"),
+				protocolName: utils.Ptr("tcp"),
+			},
+			&sshRuleAPI,
+			false,
+		},
+		{
+			"any_protocol",
+			&rule{
+				direction: utils.Ptr("ingress"),
+				ipRange:   utils.Ptr("0.0.0.0/0"),
+				portRange: &iaas.PortRange{Max: utils.Ptr(int64(22)), Min: utils.Ptr(int64(22))},
+			},
+			&sshRuleAPI,
+			false,
+		},
+		{
+			"default_ether_type",
+			&rule{
+				direction: utils.Ptr("egress"),
+			},
+			&iaas.SecurityGroupRule{
+				Direction:   utils.Ptr("egress"),
+				Description: utils.Ptr(""),
+				Protocol:    &iaas.Protocol{},
+			},
+			true,
+		},
+		{
+			"different_ether_type",
+			&rule{
+				direction:   utils.Ptr("egress"),
+				description: utils.Ptr("default"),
+			},
+			&egressRuleAPI,
+			false,
+		},
+		{
+			"icmp_parameters",
+			&rule{
+				direction:      utils.Ptr("ingress"),
+				protocolName:   utils.Ptr("icmp"),
+				icmpParameters: &iaas.ICMPParameters{Code: utils.Ptr(int64(0)), Type: utils.Ptr(int64(8))},
+			},
+			&iaas.SecurityGroupRule{
+				Direction:      utils.Ptr("ingress"),
+				Protocol:       &iaas.Protocol{Name: utils.Ptr("icmp"), Number: utils.Ptr(int64(1))},
+				IcmpParameters: &iaas.ICMPParameters{Code: utils.Ptr(int64(0)), Type: utils.Ptr(int64(8))},
+			},
+			true,
+		},
+		{
+			"nil_actual",
+			&rule{},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := tt.rule.matches(tt.actual)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestMatchRules(t *testing.T) {
+	ingress := &rule{direction: utils.Ptr("ingress")}
+	egress := &rule{direction: utils.Ptr("egress")}
+	ingressAPI := func(id string) iaas.SecurityGroupRule {
+		return iaas.SecurityGroupRule{Id: utils.Ptr(id), Direction: utils.Ptr("ingress")}
+	}
+
+	tests := []struct {
+		description       string
+		rules             []*rule
+		actual            []iaas.SecurityGroupRule
+		expectedMissing   []*rule
+		expectedUnmatched []string
+	}{
+		{
+			"in_sync",
+			[]*rule{ingress},
+			[]iaas.SecurityGroupRule{ingressAPI("a")},
+			nil,
+			nil,
+		},
+		{
+			"create_and_delete",
+			[]*rule{egress},
+			[]iaas.SecurityGroupRule{ingressAPI("a")},
+			[]*rule{egress},
+			[]string{"a"},
+		},
+		{
+			"duplicate_rule_deleted",
+			[]*rule{ingress},
+			[]iaas.SecurityGroupRule{ingressAPI("a"), ingressAPI("b")},
+			nil,
+			[]string{"b"},
+		},
+		{
+			"duplicate_rule_created",
+			[]*rule{ingress, ingress},
+			[]iaas.SecurityGroupRule{ingressAPI("a")},
+			[]*rule{ingress},
+			nil,
+		},
+		{
+			"empty",
+			nil,
+			nil,
+			nil,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			missing, unmatched := matchRules(tt.rules, tt.actual)
+			if len(missing) != len(tt.expectedMissing) {
+				t.Fatalf("Expected %d missing rules, got %d", len(tt.expectedMissing), len(missing))
+			}
+			for i := range missing {
+				if missing[i] != tt.expectedMissing[i] {
+					t.Fatalf("Missing rule %d does not match", i)
+				}
+			}
+			var unmatchedIds []string
+			for _, rule := range unmatched {
+				unmatchedIds = append(unmatchedIds, rule.GetId())
+			}
+			diff := cmp.Diff(unmatchedIds, tt.expectedUnmatched)
+			if diff != "" {
+				t.Fatalf("Unmatched rules do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *rule
+		expected    *iaas.CreateSecurityGroupRulePayload
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&rule{},
+			&iaas.CreateSecurityGroupRulePayload{},
+			true,
+		},
+		{
+			"simple_values",
+			&rule{
+				direction:      utils.Ptr("ingress"),
+				description:    utils.Ptr("ssh"),
+				etherType:      utils.Ptr("IPv4"),
+				ipRange:        utils.Ptr("0.0.0.0/0"),
+				protocolName:   utils.Ptr("tcp"),
+				portRange:      &iaas.PortRange{Max: utils.Ptr(int64(22)), Min: utils.Ptr(int64(22))},
+				icmpParameters: nil,
+			},
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction:   utils.Ptr("ingress"),
+				Description: utils.Ptr("ssh"),
+				Ethertype:   utils.Ptr("IPv4"),
+				IpRange:     utils.Ptr("0.0.0.0/0"),
+				Protocol: &iaas.CreateProtocol{
+					String: utils.Ptr("tcp"),
+				},
+				PortRange: &iaas.PortRange{Max: utils.Ptr(int64(22)), Min: utils.Ptr(int64(22))},
+			},
+			true,
+		},
+		{
+			"protocol_number",
+			&rule{
+				direction:             utils.Ptr("ingress"),
+				remoteSecurityGroupId: utils.Ptr("sgid"),
+				protocolNumber:        utils.Ptr(int64(112)),
+			},
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction:             utils.Ptr("ingress"),
+				RemoteSecurityGroupId: utils.Ptr("sgid"),
+				Protocol: &iaas.CreateProtocol{
+					Int64: utils.Ptr(int64(112)),
+				},
+			},
+			true,
+		},
+		{
+			"nil_rule",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toCreatePayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package securitygroups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &securityGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &securityGroupsDataSource{}
+)
+
+type DataSourceModel struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	ProjectId      types.String `tfsdk:"project_id"`
+	Labels         types.Map    `tfsdk:"labels"`
+	SecurityGroups types.List   `tfsdk:"security_groups"`
+}
+
+// Types corresponding to a single element of DataSourceModel.SecurityGroups
+var securityGroupTypes = map[string]attr.Type{
+	"security_group_id": types.StringType,
+	"name":              types.StringType,
+	"description":       types.StringType,
+	"labels":            types.MapType{ElemType: types.StringType},
+	"stateful":          types.BoolType,
+}
+
+// NewSecurityGroupsDataSource is a helper function to simplify the provider implementation.
+func NewSecurityGroupsDataSource() datasource.DataSource {
+	return &securityGroupsDataSource{}
+}
+
+// securityGroupsDataSource is the data source implementation.
+type securityGroupsDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *securityGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_groups"
+}
+
+func (d *securityGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *securityGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Security groups datasource schema. Lists the security groups of a project. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal datasource ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID for which the security groups are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Only security groups which have all of these labels are listed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"security_groups": schema.ListNestedAttribute{
+				Description: "List of security groups, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"security_group_id": schema.StringAttribute{
+							Description: "The security group ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the security group.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the security group.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"stateful": schema.BoolAttribute{
+							Description: "Configures if a security group is stateful or stateless.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *securityGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	labelSelector, err := iaasUtils.LabelSelector(ctx, model.Labels)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security groups", fmt.Sprintf("Building label selector: %v", err))
+		return
+	}
+	securityGroupsReq := d.client.ListSecurityGroups(ctx, projectId)
+	if labelSelector != "" {
+		securityGroupsReq = securityGroupsReq.LabelSelector(labelSelector)
+	}
+	securityGroupsResp, err := securityGroupsReq.Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading security groups",
+			fmt.Sprintf("Security groups cannot be listed for project %q.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapDataSourceFields(ctx, securityGroupsResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security groups", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Security groups read")
+}
+
+func mapDataSourceFields(ctx context.Context, securityGroupsResp *iaas.SecurityGroupListResponse, model *DataSourceModel) error {
+	if securityGroupsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString())
+
+	securityGroups := make([]iaas.SecurityGroup, len(securityGroupsResp.GetItems()))
+	copy(securityGroups, securityGroupsResp.GetItems())
+	// Sort to get a stable result, the API doesn't guarantee any order
+	sort.SliceStable(securityGroups, func(i, j int) bool {
+		if securityGroups[i].GetName() != securityGroups[j].GetName() {
+			return securityGroups[i].GetName() < securityGroups[j].GetName()
+		}
+		return securityGroups[i].GetId() < securityGroups[j].GetId()
+	})
+
+	securityGroupsList := []attr.Value{}
+	for i := range securityGroups {
+		securityGroup := securityGroups[i]
+
+		labels, err := iaasUtils.MapLabels(ctx, securityGroup.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return fmt.Errorf("mapping labels of index %d: %w", i, err)
+		}
+
+		securityGroupTF, diags := types.ObjectValue(securityGroupTypes, map[string]attr.Value{
+			"security_group_id": types.StringPointerValue(securityGroup.Id),
+			"name":              types.StringPointerValue(securityGroup.Name),
+			"description":       types.StringPointerValue(securityGroup.Description),
+			"labels":            labels,
+			"stateful":          types.BoolPointerValue(securityGroup.Stateful),
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		securityGroupsList = append(securityGroupsList, securityGroupTF)
+	}
+
+	securityGroupsTF, diags := types.ListValue(types.ObjectType{AttrTypes: securityGroupTypes}, securityGroupsList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.SecurityGroups = securityGroupsTF
+	return nil
+}
@@ -0,0 +1,115 @@
+package securitygroups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.SecurityGroupListResponse
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				Labels:    types.MapNull(types.StringType),
+			},
+			&iaas.SecurityGroupListResponse{},
+			DataSourceModel{
+				Id:             types.StringValue("pid"),
+				ProjectId:      types.StringValue("pid"),
+				Labels:         types.MapNull(types.StringType),
+				SecurityGroups: types.ListValueMust(types.ObjectType{AttrTypes: securityGroupTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"simple_values_sorted",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"env": types.StringValue("prod"),
+				}),
+			},
+			&iaas.SecurityGroupListResponse{
+				Items: &[]iaas.SecurityGroup{
+					{
+						Id:          utils.Ptr("sgid-2"),
+						Name:        utils.Ptr("web"),
+						Description: utils.Ptr("web servers"),
+						Labels: &map[string]interface{}{
+							"env": "prod",
+						},
+						Stateful: utils.Ptr(true),
+					},
+					{
+						Id:       utils.Ptr("sgid-1"),
+						Name:     utils.Ptr("db"),
+						Stateful: utils.Ptr(false),
+					},
+				},
+			},
+			DataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"env": types.StringValue("prod"),
+				}),
+				SecurityGroups: types.ListValueMust(types.ObjectType{AttrTypes: securityGroupTypes}, []attr.Value{
+					types.ObjectValueMust(securityGroupTypes, map[string]attr.Value{
+						"security_group_id": types.StringValue("sgid-1"),
+						"name":              types.StringValue("db"),
+						"description":       types.StringNull(),
+						"labels":            types.MapNull(types.StringType),
+						"stateful":          types.BoolValue(false),
+					}),
+					types.ObjectValueMust(securityGroupTypes, map[string]attr.Value{
+						"security_group_id": types.StringValue("sgid-2"),
+						"name":              types.StringValue("web"),
+						"description":       types.StringValue("web servers"),
+						"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"env": types.StringValue("prod"),
+						}),
+						"stateful": types.BoolValue(true),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			DataSourceModel{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
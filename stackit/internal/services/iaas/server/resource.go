@@ -2,10 +2,10 @@ package server
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 	"net/http"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -42,6 +43,7 @@ var (
 	_ resource.Resource                = &serverResource{}
 	_ resource.ResourceWithConfigure   = &serverResource{}
 	_ resource.ResourceWithImportState = &serverResource{}
+	_ resource.ResourceWithModifyPlan  = &serverResource{}
 
 	supportedSourceTypes = []string{"volume", "image"}
 	desiredStatusOptions = []string{modelStateActive, modelStateInactive, modelStateDeallocated}
@@ -145,6 +147,87 @@ func (r *serverResource) ConfigValidators(_ context.Context) []resource.ConfigVa
 	}
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to reject machine types and availability zones which are not offered by the API before the server is created or resized.
+func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip the validation on destroy and if the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateModel Model
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	validateMachineType(ctx, r.client, &planModel, &stateModel, &resp.Diagnostics)
+	validateAvailabilityZone(ctx, r.client, &planModel, &stateModel, &resp.Diagnostics)
+}
+
+// catalogueClient provides a mockable interface for the necessary
+// client operations in [validateMachineType] and [validateAvailabilityZone]
+type catalogueClient interface {
+	GetMachineTypeExecute(ctx context.Context, projectId string, machineType string) (*iaas.MachineType, error)
+	ListAvailabilityZonesExecute(ctx context.Context) (*iaas.AvailabilityZoneListResponse, error)
+}
+
+// validateMachineType checks that the planned machine type is offered in the project.
+// Unchanged values are not checked again, so existing servers don't cause additional API calls on every plan.
+func validateMachineType(ctx context.Context, client catalogueClient, planModel, stateModel *Model, diags *diag.Diagnostics) {
+	if planModel.MachineType.IsNull() || planModel.MachineType.IsUnknown() || planModel.ProjectId.IsUnknown() {
+		return
+	}
+	if planModel.MachineType.Equal(stateModel.MachineType) {
+		return
+	}
+
+	projectId := planModel.ProjectId.ValueString()
+	machineType := planModel.MachineType.ValueString()
+	_, err := client.GetMachineTypeExecute(ctx, projectId, machineType)
+	if err == nil {
+		return
+	}
+	oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+	if ok && oapiErr.StatusCode == http.StatusNotFound {
+		core.LogAndAddError(ctx, diags, "Error validating server", fmt.Sprintf("The machine type %q is not available in project %q. Use the `stackit_machine_types` data source to list the available machine types.", machineType, projectId))
+		return
+	}
+	// Don't block the plan if the catalogue can't be reached, the API validates the machine type again on apply
+	tflog.Warn(ctx, fmt.Sprintf("cannot validate machine type %q: %v", machineType, err))
+}
+
+// validateAvailabilityZone checks that the planned availability zone exists in the region.
+// Unchanged values are not checked again, so existing servers don't cause additional API calls on every plan.
+func validateAvailabilityZone(ctx context.Context, client catalogueClient, planModel, stateModel *Model, diags *diag.Diagnostics) {
+	if planModel.AvailabilityZone.IsNull() || planModel.AvailabilityZone.IsUnknown() {
+		return
+	}
+	if planModel.AvailabilityZone.Equal(stateModel.AvailabilityZone) {
+		return
+	}
+
+	availabilityZone := planModel.AvailabilityZone.ValueString()
+	availabilityZonesResp, err := client.ListAvailabilityZonesExecute(ctx)
+	if err != nil {
+		// Don't block the plan if the catalogue can't be reached, the API validates the availability zone again on apply
+		tflog.Warn(ctx, fmt.Sprintf("cannot validate availability zone %q: %v", availabilityZone, err))
+		return
+	}
+	availabilityZones := availabilityZonesResp.GetItems()
+	if !slices.Contains(availabilityZones, availabilityZone) {
+		core.LogAndAddError(ctx, diags, "Error validating server", fmt.Sprintf("The availability zone %q does not exist. %s", availabilityZone, utils.FormatPossibleValues(availabilityZones...)))
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *serverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -344,11 +427,14 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"user_data": schema.StringAttribute{
-				Description: "User data that is passed via cloud-init to the server.",
+				Description: "User data that is passed via cloud-init to the server. Either plain text or base64 encoded, e.g. the gzip compressed output of the `stackit_cloudinit_config` data source. Base64 encoded values are passed on unchanged.",
 				Optional:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					validate.UserData(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				Description: "Date-time when the server was created",
@@ -446,9 +532,20 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	serverId := *server.Id
-	_, err = wait.CreateServerWaitHandler(ctx, r.client, projectId, serverId).WaitWithContext(ctx)
+	waitResp, err := wait.CreateServerWaitHandler(ctx, r.client, projectId, serverId).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", fmt.Sprintf("server creation waiting: %v", err))
+		detail := fmt.Sprintf("server creation waiting: %v", err)
+		if waitResp != nil && waitResp.GetStatus() == wait.ErrorStatus {
+			var affinityGroup *iaas.AffinityGroup
+			if affinityGroupId := model.AffinityGroup.ValueString(); affinityGroupId != "" {
+				affinityGroup, err = r.client.GetAffinityGroup(ctx, projectId, affinityGroupId).Execute()
+				if err != nil {
+					tflog.Warn(ctx, fmt.Sprintf("Getting affinity group of the failed server: %v", err))
+				}
+			}
+			detail += placementFailureHint(waitResp, affinityGroup)
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", detail)
 		return
 	}
 	ctx = tflog.SetField(ctx, "server_id", serverId)
@@ -976,9 +1073,7 @@ func toCreatePayload(ctx context.Context, model *Model) (*iaas.CreateServerPaylo
 
 	var userData *[]byte
 	if !model.UserData.IsNull() && !model.UserData.IsUnknown() {
-		src := []byte(model.UserData.ValueString())
-		encodedUserData := make([]byte, base64.StdEncoding.EncodedLen(len(src)))
-		base64.StdEncoding.Encode(encodedUserData, src)
+		encodedUserData := utils.UserDataPayload(model.UserData.ValueString())
 		userData = &encodedUserData
 	}
 
@@ -1029,3 +1124,19 @@ func toUpdatePayload(ctx context.Context, model *Model, currentLabels types.Map)
 		Labels: &labels,
 	}, nil
 }
+
+// placementFailureHint describes the error of a server which ended in status ERROR and,
+// if the server is part of an affinity group with a hard policy, points out that the policy can make the placement fail
+func placementFailureHint(server *iaas.Server, affinityGroup *iaas.AffinityGroup) string {
+	if server == nil || server.GetStatus() != wait.ErrorStatus {
+		return ""
+	}
+	hint := fmt.Sprintf(". The server ended in status %s", wait.ErrorStatus)
+	if errorMessage := server.GetErrorMessage(); errorMessage != "" {
+		hint += fmt.Sprintf(" with the error %q", errorMessage)
+	}
+	if affinityGroup == nil || !strings.HasPrefix(affinityGroup.GetPolicy(), "hard-") {
+		return hint
+	}
+	return hint + fmt.Sprintf(". The server is part of the affinity group %q with the hard policy %q, which fails the placement if no compute node can satisfy it. If this is the cause, a soft policy places the server anyway", affinityGroup.GetName(), affinityGroup.GetPolicy())
+}
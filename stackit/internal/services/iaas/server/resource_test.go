@@ -2,13 +2,17 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
@@ -264,6 +268,26 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"user data is already base64 encoded",
+			&Model{
+				Name:        types.StringValue("name"),
+				Labels:      types.MapNull(types.StringType),
+				BootVolume:  types.ObjectNull(bootVolumeTypes),
+				ImageId:     types.StringValue("image"),
+				MachineType: types.StringValue("machine_type"),
+				// "#cloud-config\n"
+				UserData: types.StringValue("I2Nsb3VkLWNvbmZpZwo="),
+			},
+			&iaas.CreateServerPayload{
+				Name:        utils.Ptr("name"),
+				Labels:      &map[string]interface{}{},
+				ImageId:     utils.Ptr("image"),
+				MachineType: utils.Ptr("machine_type"),
+				UserData:    utils.Ptr([]byte("I2Nsb3VkLWNvbmZpZwo=")),
+			},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
@@ -589,3 +613,256 @@ func Test_serverResource_updateServerStatus(t *testing.T) {
 		})
 	}
 }
+
+var _ catalogueClient = (*mockCatalogueClient)(nil)
+
+// mockCatalogueClient mocks the [catalogueClient] interface with
+// a fixed set of machine types and availability zones
+type mockCatalogueClient struct {
+	machineTypes      []string
+	availabilityZones []string
+	err               error
+
+	getMachineTypeCalled        int
+	listAvailabilityZonesCalled int
+}
+
+// GetMachineTypeExecute implements catalogueClient.
+func (c *mockCatalogueClient) GetMachineTypeExecute(_ context.Context, _, machineType string) (*iaas.MachineType, error) {
+	c.getMachineTypeCalled++
+	if c.err != nil {
+		return nil, c.err
+	}
+	for _, name := range c.machineTypes {
+		if name == machineType {
+			return &iaas.MachineType{Name: utils.Ptr(name)}, nil
+		}
+	}
+	return nil, &oapierror.GenericOpenAPIError{StatusCode: http.StatusNotFound}
+}
+
+// ListAvailabilityZonesExecute implements catalogueClient.
+func (c *mockCatalogueClient) ListAvailabilityZonesExecute(_ context.Context) (*iaas.AvailabilityZoneListResponse, error) {
+	c.listAvailabilityZonesCalled++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &iaas.AvailabilityZoneListResponse{Items: &c.availabilityZones}, nil
+}
+
+func TestValidateMachineType(t *testing.T) {
+	tests := []struct {
+		description string
+		plan        Model
+		state       Model
+		clientErr   error
+		calls       int
+		isValid     bool
+	}{
+		{
+			"known_machine_type",
+			Model{ProjectId: types.StringValue("pid"), MachineType: types.StringValue("g1.1")},
+			Model{},
+			nil,
+			1,
+			true,
+		},
+		{
+			"unknown_machine_type",
+			Model{ProjectId: types.StringValue("pid"), MachineType: types.StringValue("g9.99")},
+			Model{},
+			nil,
+			1,
+			false,
+		},
+		{
+			"unchanged_machine_type",
+			Model{ProjectId: types.StringValue("pid"), MachineType: types.StringValue("g9.99")},
+			Model{ProjectId: types.StringValue("pid"), MachineType: types.StringValue("g9.99")},
+			nil,
+			0,
+			true,
+		},
+		{
+			"unknown_value",
+			Model{ProjectId: types.StringValue("pid"), MachineType: types.StringUnknown()},
+			Model{},
+			nil,
+			0,
+			true,
+		},
+		{
+			"api_error",
+			Model{ProjectId: types.StringValue("pid"), MachineType: types.StringValue("g9.99")},
+			Model{},
+			fmt.Errorf("connection refused"),
+			1,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := &mockCatalogueClient{
+				machineTypes: []string{"g1.1", "c1.2"},
+				err:          tt.clientErr,
+			}
+			var diags diag.Diagnostics
+			validateMachineType(context.Background(), client, &tt.plan, &tt.state, &diags)
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if client.getMachineTypeCalled != tt.calls {
+				t.Fatalf("Expected %d API calls, got %d", tt.calls, client.getMachineTypeCalled)
+			}
+		})
+	}
+}
+
+func TestValidateAvailabilityZone(t *testing.T) {
+	tests := []struct {
+		description string
+		plan        Model
+		state       Model
+		clientErr   error
+		calls       int
+		isValid     bool
+	}{
+		{
+			"known_availability_zone",
+			Model{AvailabilityZone: types.StringValue("eu01-1")},
+			Model{},
+			nil,
+			1,
+			true,
+		},
+		{
+			"unknown_availability_zone",
+			Model{AvailabilityZone: types.StringValue("eu01-9")},
+			Model{},
+			nil,
+			1,
+			false,
+		},
+		{
+			"unchanged_availability_zone",
+			Model{AvailabilityZone: types.StringValue("eu01-9")},
+			Model{AvailabilityZone: types.StringValue("eu01-9")},
+			nil,
+			0,
+			true,
+		},
+		{
+			"computed_availability_zone",
+			Model{AvailabilityZone: types.StringUnknown()},
+			Model{},
+			nil,
+			0,
+			true,
+		},
+		{
+			"api_error",
+			Model{AvailabilityZone: types.StringValue("eu01-9")},
+			Model{},
+			fmt.Errorf("connection refused"),
+			1,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := &mockCatalogueClient{
+				availabilityZones: []string{"eu01-1", "eu01-2", "eu01-3"},
+				err:               tt.clientErr,
+			}
+			var diags diag.Diagnostics
+			validateAvailabilityZone(context.Background(), client, &tt.plan, &tt.state, &diags)
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if client.listAvailabilityZonesCalled != tt.calls {
+				t.Fatalf("Expected %d API calls, got %d", tt.calls, client.listAvailabilityZonesCalled)
+			}
+		})
+	}
+}
+
+func TestPlacementFailureHint(t *testing.T) {
+	hardAffinityGroup := &iaas.AffinityGroup{
+		Name:   utils.Ptr("group"),
+		Policy: utils.Ptr("hard-anti-affinity"),
+	}
+	tests := []struct {
+		description   string
+		server        *iaas.Server
+		affinityGroup *iaas.AffinityGroup
+		expected      string
+	}{
+		{
+			"hard_policy",
+			&iaas.Server{
+				Status:       utils.Ptr("ERROR"),
+				ErrorMessage: utils.Ptr("No valid host was found."),
+			},
+			hardAffinityGroup,
+			`. The server ended in status ERROR with the error "No valid host was found.". The server is part of the affinity group "group" with the hard policy "hard-anti-affinity", which fails the placement if no compute node can satisfy it. If this is the cause, a soft policy places the server anyway`,
+		},
+		{
+			"soft_policy",
+			&iaas.Server{
+				Status:       utils.Ptr("ERROR"),
+				ErrorMessage: utils.Ptr("No valid host was found."),
+			},
+			&iaas.AffinityGroup{
+				Name:   utils.Ptr("group"),
+				Policy: utils.Ptr("soft-affinity"),
+			},
+			`. The server ended in status ERROR with the error "No valid host was found."`,
+		},
+		{
+			"no_error_message",
+			&iaas.Server{
+				Status: utils.Ptr("ERROR"),
+			},
+			nil,
+			". The server ended in status ERROR",
+		},
+		{
+			"no_policy",
+			&iaas.Server{
+				Status: utils.Ptr("ERROR"),
+			},
+			&iaas.AffinityGroup{
+				Name: utils.Ptr("group"),
+			},
+			". The server ended in status ERROR",
+		},
+		{
+			"server_not_in_error",
+			&iaas.Server{
+				Status: utils.Ptr("CREATING"),
+			},
+			hardAffinityGroup,
+			"",
+		},
+		{
+			"nil_server",
+			nil,
+			hardAffinityGroup,
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := placementFailureHint(tt.server, tt.affinityGroup)
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,854 @@
+package servergroup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/loadbalancer"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	loadbalancerUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/loadbalancer/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+const (
+	// groupLabel is set on every member of a server group, its value is the name of the group
+	groupLabel = "stackit-server-group"
+	// templateHashLabel is set on every member of a server group, its value is the hash of the template the member was created from
+	templateHashLabel = "stackit-server-group-template"
+
+	defaultMaxUnavailable     = 1
+	defaultHealthCheckTimeout = 10 * time.Minute
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &serverGroupResource{}
+	_ resource.ResourceWithConfigure   = &serverGroupResource{}
+	_ resource.ResourceWithImportState = &serverGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &serverGroupResource{}
+)
+
+type Model struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	ProjectId      types.String `tfsdk:"project_id"`
+	Name           types.String `tfsdk:"name"`
+	Size           types.Int64  `tfsdk:"size"`
+	CurrentSize    types.Int64  `tfsdk:"current_size"`
+	ServerTemplate types.Object `tfsdk:"server_template"`
+	RollingUpdate  types.Object `tfsdk:"rolling_update"`
+	LoadBalancer   types.Object `tfsdk:"load_balancer"`
+	TemplateHash   types.String `tfsdk:"template_hash"`
+	Members        types.List   `tfsdk:"members"`
+}
+
+// Struct corresponding to Model.ServerTemplate
+type serverTemplateModel struct {
+	MachineType                types.String `tfsdk:"machine_type"`
+	ImageId                    types.String `tfsdk:"image_id"`
+	BootVolumeSize             types.Int64  `tfsdk:"boot_volume_size"`
+	BootVolumePerformanceClass types.String `tfsdk:"boot_volume_performance_class"`
+	NetworkId                  types.String `tfsdk:"network_id"`
+	SecurityGroups             types.List   `tfsdk:"security_groups"`
+	AvailabilityZone           types.String `tfsdk:"availability_zone"`
+	KeypairName                types.String `tfsdk:"keypair_name"`
+	AffinityGroup              types.String `tfsdk:"affinity_group"`
+	UserData                   types.String `tfsdk:"user_data"`
+	Labels                     types.Map    `tfsdk:"labels"`
+}
+
+// Struct corresponding to Model.RollingUpdate
+type rollingUpdateModel struct {
+	MaxUnavailable         types.Int64  `tfsdk:"max_unavailable"`
+	HealthCheckGracePeriod types.String `tfsdk:"health_check_grace_period"`
+	HealthCheckTimeout     types.String `tfsdk:"health_check_timeout"`
+}
+
+// Struct corresponding to Model.LoadBalancer
+type loadBalancerModel struct {
+	Name           types.String `tfsdk:"name"`
+	TargetPoolName types.String `tfsdk:"target_pool_name"`
+}
+
+// Types corresponding to a single element of Model.Members
+var memberTypes = map[string]attr.Type{
+	"server_id":         types.StringType,
+	"name":              types.StringType,
+	"availability_zone": types.StringType,
+	"ip":                types.StringType,
+	"template_hash":     types.StringType,
+}
+
+// NewServerGroupResource is a helper function to simplify the provider implementation.
+func NewServerGroupResource() resource.Resource {
+	return &serverGroupResource{}
+}
+
+// serverGroupResource is the resource implementation.
+type serverGroupResource struct {
+	client       *iaas.APIClient
+	lbClient     *loadbalancer.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *serverGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_group"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serverGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &r.providerData, &resp.Diagnostics, "stackit_server_group", "resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	lbClient := loadbalancerUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	r.lbClient = lbClient
+	tflog.Info(ctx, "iaas and load balancer clients configured")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Plans an update if the number of members differs from the configured size.
+func (r *serverGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// If the state is empty we are creating a new resource
+	// If the plan is empty we are deleting the resource
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var stateModel Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !markSizeDrift(&stateModel, &planModel) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// markSizeDrift marks the members in the plan as unknown if the current number of members differs from the planned size,
+// e.g. after a failed rollout or if members were deleted outside of Terraform. It returns whether the plan was changed.
+func markSizeDrift(stateModel, planModel *Model) bool {
+	if planModel.Size.IsUnknown() || stateModel.CurrentSize.IsNull() || stateModel.CurrentSize.Equal(planModel.Size) {
+		return false
+	}
+	planModel.CurrentSize = types.Int64Unknown()
+	planModel.Members = types.ListUnknown(types.ObjectType{AttrTypes: memberTypes})
+	return true
+}
+
+// Schema defines the schema for the resource.
+func (r *serverGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Server group resource schema. Manages a group of identical servers created from a common template. " +
+		"Changes to the template replace the members in batches according to the `rolling_update` policy instead of replacing all servers at once. " +
+		"With `load_balancer`, new members count as healthy once they are targets of the pool and, after the grace period and enough intervals of the pool's active health check to reach its thresholds, the load balancer reports no `TYPE_TARGET_NOT_ACTIVE` error naming their IP. The load balancer API doesn't report the health per target, so this check is a heuristic. " +
+		"Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: features.AddBetaDescription(description, core.Resource),
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`name`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the server group is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the server group. Must consist of lowercase letters, digits and hyphens. The members are named `<name>-<suffix>` and labeled with `" + groupLabel + "=<name>`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					// leave room for the member suffix, the server name is limited to 63 characters
+					stringvalidator.LengthAtMost(54),
+					// the name is used as label value, which only allows lowercase letters, digits, `-` and `_`
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+						"must match expression"),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Description: "The desired number of servers in the group.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"server_template": schema.SingleNestedAttribute{
+				Description: "The template the members of the group are created from. Changing it replaces the members according to the `rolling_update` policy.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"machine_type": schema.StringAttribute{
+						Description: "Name of the type of the machine for the servers.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.LengthAtMost(63),
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^[A-Za-z0-9]+((-|_|\s|\.)[A-Za-z0-9]+)*$`),
+								"must match expression"),
+						},
+					},
+					"image_id": schema.StringAttribute{
+						Description: "The ID of the image the servers are booted from.",
+						Required:    true,
+						Validators: []validator.String{
+							validate.UUID(),
+							validate.NoSeparator(),
+						},
+					},
+					"boot_volume_size": schema.Int64Attribute{
+						Description: "The size of the boot volume in GB. If set, every server gets a boot volume created from the image, which is deleted together with the server. Otherwise an ephemeral disk is used.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"boot_volume_performance_class": schema.StringAttribute{
+						Description: "The performance class of the boot volume. Requires `boot_volume_size` to be set.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("boot_volume_size")),
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.LengthAtMost(63),
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^[A-Za-z0-9]+((-|_|\s|\.)[A-Za-z0-9]+)*$`),
+								"must match expression"),
+						},
+					},
+					"network_id": schema.StringAttribute{
+						Description: "The ID of the network a network interface is created in for every server.",
+						Required:    true,
+						Validators: []validator.String{
+							validate.UUID(),
+							validate.NoSeparator(),
+						},
+					},
+					"security_groups": schema.ListAttribute{
+						Description: "The IDs of the security groups which are applied to the servers.",
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(
+								validate.UUID(),
+								validate.NoSeparator(),
+							),
+						},
+					},
+					"availability_zone": schema.StringAttribute{
+						Description: "The availability zone of the servers.",
+						Optional:    true,
+					},
+					"keypair_name": schema.StringAttribute{
+						Description: "The name of the keypair used during server creation.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.LengthAtMost(63),
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^[A-Za-z0-9]+((-|_|\s|\.)[A-Za-z0-9]+)*$`),
+								"must match expression"),
+						},
+					},
+					"affinity_group": schema.StringAttribute{
+						Description: "The affinity group the servers are assigned to.",
+						Optional:    true,
+						Validators: []validator.String{
+							validate.UUID(),
+							validate.NoSeparator(),
+						},
+					},
+					"user_data": schema.StringAttribute{
+						Description: "User data that is passed via cloud-init to the servers. Either plain text or base64 encoded, e.g. the gzip compressed output of the `stackit_cloudinit_config` data source.",
+						Optional:    true,
+						Validators: []validator.String{
+							validate.UserData(),
+						},
+					},
+					"labels": schema.MapAttribute{
+						Description: fmt.Sprintf("Labels which are attached to the servers. The labels `%s` and `%s` are reserved.", groupLabel, templateHashLabel),
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"rolling_update": schema.SingleNestedAttribute{
+				Description: "Policy for replacing members after a change of the `server_template`.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"max_unavailable": schema.Int64Attribute{
+						Description: fmt.Sprintf("Maximum number of members which are replaced at the same time. Defaults to `%d`.", defaultMaxUnavailable),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"health_check_grace_period": schema.StringAttribute{
+						Description: "Time to wait after a batch of new members was registered in the load balancer target pool before their health is checked, e.g. `60s`. Should cover the boot time of the servers. The intervals the active health check of the target pool needs to reach its thresholds are waited for in addition. Without `load_balancer`, this is a blind wait between two batches. Defaults to `0s`.",
+						Optional:    true,
+						Validators: []validator.String{
+							validate.ValidDurationString(),
+						},
+					},
+					"health_check_timeout": schema.StringAttribute{
+						Description: fmt.Sprintf("Time after the grace period within which the load balancer must report a batch of new members as healthy, e.g. `5m`. Otherwise the rolling update is aborted and the remaining outdated members are kept. Only used with `load_balancer`. Defaults to `%s`.", defaultHealthCheckTimeout),
+						Optional:    true,
+						Validators: []validator.String{
+							validate.ValidDurationString(),
+						},
+					},
+				},
+			},
+			"load_balancer": schema.SingleNestedAttribute{
+				Description: "Load balancer target pool the members are registered in. During a rolling update, the next batch of members is only replaced after the load balancer reports the new members as healthy, which requires an active health check in the target pool. Targets of the pool which don't belong to the group are kept.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "The name of the load balancer.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"target_pool_name": schema.StringAttribute{
+						Description: "The name of the target pool of the load balancer.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+			"current_size": schema.Int64Attribute{
+				Description: "The current number of servers in the group. Differs from `size` if a rollout failed or members were deleted outside of Terraform, the group is then brought back to `size` during the next apply.",
+				Computed:    true,
+			},
+			"template_hash": schema.StringAttribute{
+				Description: "Hash of the `server_template`. Members with a different hash are outdated and replaced during the next apply.",
+				Computed:    true,
+			},
+			"members": schema.ListNestedAttribute{
+				Description: "The servers of the group, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server_id": schema.StringAttribute{
+							Description: "The ID of the server.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the server.",
+							Computed:    true,
+						},
+						"availability_zone": schema.StringAttribute{
+							Description: "The availability zone of the server.",
+							Computed:    true,
+						},
+						"ip": schema.StringAttribute{
+							Description: "The private IPv4 address of the server in the network of the template.",
+							Computed:    true,
+						},
+						"template_hash": schema.StringAttribute{
+							Description: "Hash of the template the server was created from.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serverGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	g, err := toGroup(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server group", fmt.Sprintf("Processing configuration: %v", err))
+		return
+	}
+
+	// Adopt servers which are left over from a previous failed apply
+	existing, err := r.listMembers(ctx, projectId, name)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server group", fmt.Sprintf("Listing existing members: %v", err))
+		return
+	}
+
+	members, err := r.rollout(ctx, g, existing, nil)
+	model.TemplateHash = types.StringValue(g.templateHash)
+	if setErr := mapFields(ctx, members, &model); setErr != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server group", fmt.Sprintf("Processing API payload: %v", setErr))
+		return
+	}
+	if err != nil {
+		// Save the members which were created so far, they are removed when the tainted group is destroyed
+		resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server group", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Server group created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serverGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	members, err := r.listMembers(ctx, projectId, name)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server group", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(ctx, members, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server group", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Server group read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *serverGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	// Retrieve values from state
+	var stateModel Model
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	g, err := toGroup(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server group", fmt.Sprintf("Processing configuration: %v", err))
+		return
+	}
+	stateGroup, err := toGroup(ctx, &stateModel)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server group", fmt.Sprintf("Processing state: %v", err))
+		return
+	}
+
+	existing, err := r.listMembers(ctx, projectId, name)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server group", fmt.Sprintf("Listing existing members: %v", err))
+		return
+	}
+
+	var previousLoadBalancer *loadBalancerTarget
+	if stateGroup.loadBalancer != nil && (g.loadBalancer == nil || *stateGroup.loadBalancer != *g.loadBalancer) {
+		previousLoadBalancer = stateGroup.loadBalancer
+	}
+
+	members, err := r.rollout(ctx, g, existing, previousLoadBalancer)
+	if err != nil {
+		// Keep the previous configuration in the state, so that the remaining outdated members are replaced during the next apply
+		if setErr := mapFields(ctx, members, &stateModel); setErr == nil {
+			resp.Diagnostics.Append(resp.State.Set(ctx, stateModel)...)
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server group", err.Error())
+		return
+	}
+
+	model.TemplateHash = types.StringValue(g.templateHash)
+	err = mapFields(ctx, members, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server group", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Server group updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serverGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	g, err := toGroup(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server group", fmt.Sprintf("Processing state: %v", err))
+		return
+	}
+
+	members, err := r.listMembers(ctx, projectId, name)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server group", fmt.Sprintf("Listing members: %v", err))
+		return
+	}
+
+	err = r.updateTargetPool(ctx, g, g.loadBalancer, members, nil)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server group", fmt.Sprintf("Deregistering members from load balancer: %v", err))
+		return
+	}
+	err = r.deleteMembers(ctx, projectId, members)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server group", err.Error())
+		return
+	}
+	tflog.Info(ctx, "Server group deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,name
+func (r *serverGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing server group",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[name]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	tflog.Info(ctx, "Server group state imported")
+}
+
+// listMembers returns all servers which are labeled as members of the group
+func (r *serverGroupResource) listMembers(ctx context.Context, projectId, name string) ([]member, error) {
+	serversResp, err := r.client.ListServers(ctx, projectId).
+		LabelSelector(fmt.Sprintf("%s=%s", groupLabel, name)).
+		Details(true).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	members := []member{}
+	for i := range serversResp.GetItems() {
+		members = append(members, toMember(&serversResp.GetItems()[i]))
+	}
+	return members, nil
+}
+
+func mapFields(ctx context.Context, members []member, model *Model) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.Name.ValueString())
+	model.CurrentSize = types.Int64Value(int64(len(members)))
+	// Keep the configured size, it is only unset after an import
+	if model.Size.IsNull() || model.Size.IsUnknown() {
+		model.Size = model.CurrentSize
+	}
+
+	sorted := make([]member, len(members))
+	copy(sorted, members)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].name < sorted[j].name
+	})
+
+	membersList := []attr.Value{}
+	for i := range sorted {
+		memberTF, diags := types.ObjectValue(memberTypes, map[string]attr.Value{
+			"server_id":         types.StringValue(sorted[i].serverId),
+			"name":              types.StringValue(sorted[i].name),
+			"availability_zone": types.StringValue(sorted[i].availabilityZone),
+			"ip":                types.StringValue(sorted[i].ip),
+			"template_hash":     types.StringValue(sorted[i].templateHash),
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping member %q: %w", sorted[i].name, core.DiagsToError(diags))
+		}
+		membersList = append(membersList, memberTF)
+	}
+	membersTF, diags := types.ListValue(types.ObjectType{AttrTypes: memberTypes}, membersList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Members = membersTF
+	return nil
+}
+
+func toMember(server *iaas.Server) member {
+	m := member{
+		serverId:         server.GetId(),
+		name:             server.GetName(),
+		availabilityZone: server.GetAvailabilityZone(),
+	}
+	if hash, ok := server.GetLabels()[templateHashLabel].(string); ok {
+		m.templateHash = hash
+	}
+	nics := server.GetNics()
+	if len(nics) > 0 {
+		m.ip = nics[0].GetIpv4()
+	}
+	return m
+}
+
+// toGroup converts the model to the settings needed to roll out the group
+func toGroup(ctx context.Context, model *Model) (*group, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	g := &group{
+		projectId:          model.ProjectId.ValueString(),
+		name:               model.Name.ValueString(),
+		size:               int(model.Size.ValueInt64()),
+		maxUnavailable:     defaultMaxUnavailable,
+		healthCheckTimeout: defaultHealthCheckTimeout,
+	}
+
+	if !(model.ServerTemplate.IsNull() || model.ServerTemplate.IsUnknown()) {
+		template := &serverTemplateModel{}
+		diags := model.ServerTemplate.As(ctx, template, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, fmt.Errorf("convert server template object to struct: %w", core.DiagsToError(diags))
+		}
+		payload, err := toCreatePayload(ctx, template)
+		if err != nil {
+			return nil, fmt.Errorf("building server payload: %w", err)
+		}
+		hash, err := templateHash(payload)
+		if err != nil {
+			return nil, fmt.Errorf("computing template hash: %w", err)
+		}
+		g.template = payload
+		g.templateHash = hash
+	}
+
+	if !(model.RollingUpdate.IsNull() || model.RollingUpdate.IsUnknown()) {
+		rollingUpdate := &rollingUpdateModel{}
+		diags := model.RollingUpdate.As(ctx, rollingUpdate, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, fmt.Errorf("convert rolling update object to struct: %w", core.DiagsToError(diags))
+		}
+		if !rollingUpdate.MaxUnavailable.IsNull() && !rollingUpdate.MaxUnavailable.IsUnknown() {
+			g.maxUnavailable = int(rollingUpdate.MaxUnavailable.ValueInt64())
+		}
+		if !rollingUpdate.HealthCheckGracePeriod.IsNull() && !rollingUpdate.HealthCheckGracePeriod.IsUnknown() {
+			gracePeriod, err := time.ParseDuration(rollingUpdate.HealthCheckGracePeriod.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("parsing health check grace period: %w", err)
+			}
+			g.gracePeriod = gracePeriod
+		}
+		if !rollingUpdate.HealthCheckTimeout.IsNull() && !rollingUpdate.HealthCheckTimeout.IsUnknown() {
+			healthCheckTimeout, err := time.ParseDuration(rollingUpdate.HealthCheckTimeout.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("parsing health check timeout: %w", err)
+			}
+			g.healthCheckTimeout = healthCheckTimeout
+		}
+	}
+
+	if !(model.LoadBalancer.IsNull() || model.LoadBalancer.IsUnknown()) {
+		lb := &loadBalancerModel{}
+		diags := model.LoadBalancer.As(ctx, lb, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, fmt.Errorf("convert load balancer object to struct: %w", core.DiagsToError(diags))
+		}
+		g.loadBalancer = &loadBalancerTarget{
+			name:           lb.Name.ValueString(),
+			targetPoolName: lb.TargetPoolName.ValueString(),
+		}
+	}
+	return g, nil
+}
+
+// toCreatePayload builds the payload shared by all members, the name and the group labels are set per member
+func toCreatePayload(ctx context.Context, template *serverTemplateModel) (*iaas.CreateServerPayload, error) {
+	if template == nil {
+		return nil, fmt.Errorf("nil template")
+	}
+
+	labels, err := conversion.ToStringInterfaceMap(ctx, template.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("converting to Go map: %w", err)
+	}
+	if labels == nil {
+		labels = map[string]interface{}{}
+	}
+	for _, reserved := range []string{groupLabel, templateHashLabel} {
+		if _, ok := labels[reserved]; ok {
+			return nil, fmt.Errorf("the label %q is reserved", reserved)
+		}
+	}
+
+	var bootVolume *iaas.CreateServerPayloadBootVolume
+	imageId := conversion.StringValueToPointer(template.ImageId)
+	if !template.BootVolumeSize.IsNull() && !template.BootVolumeSize.IsUnknown() {
+		bootVolume = &iaas.CreateServerPayloadBootVolume{
+			DeleteOnTermination: sdkUtils.Ptr(true),
+			PerformanceClass:    conversion.StringValueToPointer(template.BootVolumePerformanceClass),
+			Size:                conversion.Int64ValueToPointer(template.BootVolumeSize),
+			Source: &iaas.BootVolumeSource{
+				Id:   imageId,
+				Type: sdkUtils.Ptr("image"),
+			},
+		}
+		imageId = nil
+	}
+
+	var securityGroups *[]string
+	if !template.SecurityGroups.IsNull() && !template.SecurityGroups.IsUnknown() {
+		groups := []string{}
+		diags := template.SecurityGroups.ElementsAs(ctx, &groups, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting security groups: %w", core.DiagsToError(diags))
+		}
+		securityGroups = &groups
+	}
+
+	var userData *[]byte
+	if !template.UserData.IsNull() && !template.UserData.IsUnknown() {
+		encodedUserData := utils.UserDataPayload(template.UserData.ValueString())
+		userData = &encodedUserData
+	}
+
+	return &iaas.CreateServerPayload{
+		AffinityGroup:    conversion.StringValueToPointer(template.AffinityGroup),
+		AvailabilityZone: conversion.StringValueToPointer(template.AvailabilityZone),
+		BootVolume:       bootVolume,
+		ImageId:          imageId,
+		KeypairName:      conversion.StringValueToPointer(template.KeypairName),
+		Labels:           &labels,
+		MachineType:      conversion.StringValueToPointer(template.MachineType),
+		Networking: &iaas.CreateServerPayloadNetworking{
+			CreateServerNetworking: &iaas.CreateServerNetworking{
+				NetworkId: conversion.StringValueToPointer(template.NetworkId),
+			},
+		},
+		SecurityGroups: securityGroups,
+		UserData:       userData,
+	}, nil
+}
+
+// templateHash returns a stable hash of the member payload, it is used to find outdated members
+func templateHash(payload *iaas.CreateServerPayload) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16], nil
+}
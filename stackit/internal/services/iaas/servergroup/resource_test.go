@@ -0,0 +1,875 @@
+package servergroup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/loadbalancer"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		size        types.Int64
+		members     []member
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			types.Int64Value(0),
+			[]member{},
+			Model{
+				Id:          types.StringValue("pid,name"),
+				ProjectId:   types.StringValue("pid"),
+				Name:        types.StringValue("name"),
+				Size:        types.Int64Value(0),
+				CurrentSize: types.Int64Value(0),
+				Members:     types.ListValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"configured_size_kept",
+			types.Int64Value(3),
+			[]member{
+				{serverId: "sid-1", name: "name-a", availabilityZone: "eu01-1", ip: "10.0.0.1", templateHash: "hash"},
+			},
+			Model{
+				Id:          types.StringValue("pid,name"),
+				ProjectId:   types.StringValue("pid"),
+				Name:        types.StringValue("name"),
+				Size:        types.Int64Value(3),
+				CurrentSize: types.Int64Value(1),
+				Members: types.ListValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{
+					types.ObjectValueMust(memberTypes, map[string]attr.Value{
+						"server_id":         types.StringValue("sid-1"),
+						"name":              types.StringValue("name-a"),
+						"availability_zone": types.StringValue("eu01-1"),
+						"ip":                types.StringValue("10.0.0.1"),
+						"template_hash":     types.StringValue("hash"),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"members_sorted_by_name",
+			types.Int64Null(),
+			[]member{
+				{serverId: "sid-2", name: "name-b", availabilityZone: "eu01-2", ip: "10.0.0.2", templateHash: "hash"},
+				{serverId: "sid-1", name: "name-a", availabilityZone: "eu01-1", ip: "10.0.0.1", templateHash: "old"},
+			},
+			Model{
+				Id:          types.StringValue("pid,name"),
+				ProjectId:   types.StringValue("pid"),
+				Name:        types.StringValue("name"),
+				Size:        types.Int64Value(2),
+				CurrentSize: types.Int64Value(2),
+				Members: types.ListValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{
+					types.ObjectValueMust(memberTypes, map[string]attr.Value{
+						"server_id":         types.StringValue("sid-1"),
+						"name":              types.StringValue("name-a"),
+						"availability_zone": types.StringValue("eu01-1"),
+						"ip":                types.StringValue("10.0.0.1"),
+						"template_hash":     types.StringValue("old"),
+					}),
+					types.ObjectValueMust(memberTypes, map[string]attr.Value{
+						"server_id":         types.StringValue("sid-2"),
+						"name":              types.StringValue("name-b"),
+						"availability_zone": types.StringValue("eu01-2"),
+						"ip":                types.StringValue("10.0.0.2"),
+						"template_hash":     types.StringValue("hash"),
+					}),
+				}),
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				ProjectId: tt.expected.ProjectId,
+				Name:      tt.expected.Name,
+				Size:      tt.size,
+			}
+			err := mapFields(context.Background(), tt.members, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToMember(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.Server
+		expected    member
+	}{
+		{
+			"default_values",
+			&iaas.Server{
+				Id:   utils.Ptr("sid"),
+				Name: utils.Ptr("name-12345678"),
+			},
+			member{
+				serverId: "sid",
+				name:     "name-12345678",
+			},
+		},
+		{
+			"simple_values",
+			&iaas.Server{
+				Id:               utils.Ptr("sid"),
+				Name:             utils.Ptr("name-12345678"),
+				AvailabilityZone: utils.Ptr("eu01-1"),
+				Labels: &map[string]interface{}{
+					groupLabel:        "name",
+					templateHashLabel: "hash",
+				},
+				Nics: &[]iaas.ServerNetwork{
+					{Ipv4: utils.Ptr("10.0.0.1")},
+					{Ipv4: utils.Ptr("10.0.1.1")},
+				},
+			},
+			member{
+				serverId:         "sid",
+				name:             "name-12345678",
+				availabilityZone: "eu01-1",
+				ip:               "10.0.0.1",
+				templateHash:     "hash",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toMember(tt.input)
+			diff := cmp.Diff(output, tt.expected, cmp.AllowUnexported(member{}))
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *serverTemplateModel
+		expected    *iaas.CreateServerPayload
+		isValid     bool
+	}{
+		{
+			"ephemeral_disk",
+			&serverTemplateModel{
+				MachineType:    types.StringValue("g1.1"),
+				ImageId:        types.StringValue("iid"),
+				NetworkId:      types.StringValue("nid"),
+				SecurityGroups: types.ListNull(types.StringType),
+				Labels:         types.MapNull(types.StringType),
+			},
+			&iaas.CreateServerPayload{
+				ImageId:     utils.Ptr("iid"),
+				Labels:      &map[string]interface{}{},
+				MachineType: utils.Ptr("g1.1"),
+				Networking: &iaas.CreateServerPayloadNetworking{
+					CreateServerNetworking: &iaas.CreateServerNetworking{
+						NetworkId: utils.Ptr("nid"),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"boot_volume",
+			&serverTemplateModel{
+				MachineType:                types.StringValue("g1.1"),
+				ImageId:                    types.StringValue("iid"),
+				BootVolumeSize:             types.Int64Value(64),
+				BootVolumePerformanceClass: types.StringValue("storage_premium_perf1"),
+				NetworkId:                  types.StringValue("nid"),
+				SecurityGroups: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("sgid"),
+				}),
+				AvailabilityZone: types.StringValue("eu01-1"),
+				KeypairName:      types.StringValue("key"),
+				AffinityGroup:    types.StringValue("agid"),
+				UserData:         types.StringValue("#cloud-config"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+			},
+			&iaas.CreateServerPayload{
+				AffinityGroup:    utils.Ptr("agid"),
+				AvailabilityZone: utils.Ptr("eu01-1"),
+				BootVolume: &iaas.CreateServerPayloadBootVolume{
+					DeleteOnTermination: utils.Ptr(true),
+					PerformanceClass:    utils.Ptr("storage_premium_perf1"),
+					Size:                utils.Ptr(int64(64)),
+					Source: &iaas.BootVolumeSource{
+						Id:   utils.Ptr("iid"),
+						Type: utils.Ptr("image"),
+					},
+				},
+				KeypairName: utils.Ptr("key"),
+				Labels: &map[string]interface{}{
+					"key": "value",
+				},
+				MachineType: utils.Ptr("g1.1"),
+				Networking: &iaas.CreateServerPayloadNetworking{
+					CreateServerNetworking: &iaas.CreateServerNetworking{
+						NetworkId: utils.Ptr("nid"),
+					},
+				},
+				SecurityGroups: &[]string{"sgid"},
+				UserData:       utils.Ptr([]byte("I2Nsb3VkLWNvbmZpZw==")),
+			},
+			true,
+		},
+		{
+			"reserved_label",
+			&serverTemplateModel{
+				MachineType:    types.StringValue("g1.1"),
+				ImageId:        types.StringValue("iid"),
+				NetworkId:      types.StringValue("nid"),
+				SecurityGroups: types.ListNull(types.StringType),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					groupLabel: types.StringValue("value"),
+				}),
+			},
+			nil,
+			false,
+		},
+		{
+			"nil_template",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toCreatePayload(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTemplateHash(t *testing.T) {
+	payload := func(machineType string) *iaas.CreateServerPayload {
+		return &iaas.CreateServerPayload{
+			ImageId:     utils.Ptr("iid"),
+			MachineType: utils.Ptr(machineType),
+			Labels:      &map[string]interface{}{"b": "2", "a": "1"},
+		}
+	}
+
+	first, err := templateHash(payload("g1.1"))
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	second, err := templateHash(payload("g1.1"))
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	changed, err := templateHash(payload("g1.2"))
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	if first != second {
+		t.Fatalf("Hash is not stable: %q != %q", first, second)
+	}
+	if first == changed {
+		t.Fatalf("Hash did not change with the template: %q", first)
+	}
+}
+
+func TestToMemberPayload(t *testing.T) {
+	g := &group{
+		name:         "name",
+		templateHash: "hash",
+		template: &iaas.CreateServerPayload{
+			MachineType: utils.Ptr("g1.1"),
+			Labels:      &map[string]interface{}{"key": "value"},
+		},
+	}
+
+	output := toMemberPayload(g)
+	if !strings.HasPrefix(output.GetName(), "name-") || len(output.GetName()) != len("name-")+8 {
+		t.Fatalf("Unexpected member name %q", output.GetName())
+	}
+	expectedLabels := map[string]interface{}{
+		"key":             "value",
+		groupLabel:        "name",
+		templateHashLabel: "hash",
+	}
+	diff := cmp.Diff(output.GetLabels(), expectedLabels)
+	if diff != "" {
+		t.Fatalf("Labels do not match: %s", diff)
+	}
+	if len(g.template.GetLabels()) != 1 {
+		t.Fatalf("Template labels were modified: %v", g.template.GetLabels())
+	}
+}
+
+func TestPlanMemberChanges(t *testing.T) {
+	current := func(name string) member { return member{serverId: name, name: name, templateHash: "new"} }
+	outdated := func(name string) member { return member{serverId: name, name: name, templateHash: "old"} }
+
+	tests := []struct {
+		description    string
+		members        []member
+		size           int
+		maxUnavailable int
+		expected       memberChanges
+	}{
+		{
+			"no_changes",
+			[]member{current("a"), current("b")},
+			2,
+			1,
+			memberChanges{},
+		},
+		{
+			"create",
+			[]member{},
+			3,
+			1,
+			memberChanges{add: 3},
+		},
+		{
+			"scale_up",
+			[]member{current("a")},
+			3,
+			1,
+			memberChanges{add: 2},
+		},
+		{
+			"scale_down_removes_outdated_first",
+			[]member{current("a"), outdated("b"), current("c")},
+			2,
+			1,
+			memberChanges{remove: []member{outdated("b")}},
+		},
+		{
+			"replace_one_by_one",
+			[]member{outdated("c"), outdated("a"), outdated("b")},
+			3,
+			1,
+			memberChanges{replace: [][]member{{outdated("a")}, {outdated("b")}, {outdated("c")}}},
+		},
+		{
+			"replace_in_batches",
+			[]member{outdated("a"), outdated("b"), outdated("c"), current("d")},
+			4,
+			2,
+			memberChanges{replace: [][]member{{outdated("a"), outdated("b")}, {outdated("c")}}},
+		},
+		{
+			"scale_down_and_replace",
+			[]member{outdated("a"), outdated("b"), outdated("c")},
+			2,
+			1,
+			memberChanges{
+				remove:  []member{outdated("a")},
+				replace: [][]member{{outdated("b")}, {outdated("c")}},
+			},
+		},
+		{
+			"scale_up_and_replace",
+			[]member{outdated("a")},
+			2,
+			5,
+			memberChanges{
+				replace: [][]member{{outdated("a")}},
+				add:     1,
+			},
+		},
+		{
+			"invalid_max_unavailable",
+			[]member{outdated("a"), outdated("b")},
+			2,
+			0,
+			memberChanges{replace: [][]member{{outdated("a")}, {outdated("b")}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := planMemberChanges(tt.members, tt.size, "new", tt.maxUnavailable)
+			diff := cmp.Diff(output, tt.expected, cmp.AllowUnexported(memberChanges{}, member{}), cmpopts.EquateEmpty())
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToTargetPoolPayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *loadbalancer.TargetPool
+		remove      []member
+		add         []member
+		expected    *loadbalancer.UpdateTargetPoolPayload
+	}{
+		{
+			"keeps_foreign_targets",
+			&loadbalancer.TargetPool{
+				Name:       utils.Ptr("pool"),
+				TargetPort: utils.Ptr(int64(80)),
+				Targets: &[]loadbalancer.Target{
+					{DisplayName: utils.Ptr("other"), Ip: utils.Ptr("10.0.0.100")},
+					{DisplayName: utils.Ptr("name-a"), Ip: utils.Ptr("10.0.0.1")},
+				},
+			},
+			[]member{{name: "name-a", ip: "10.0.0.1"}},
+			[]member{{name: "name-b", ip: "10.0.0.2"}},
+			&loadbalancer.UpdateTargetPoolPayload{
+				Name:       utils.Ptr("pool"),
+				TargetPort: utils.Ptr(int64(80)),
+				Targets: &[]loadbalancer.Target{
+					{DisplayName: utils.Ptr("other"), Ip: utils.Ptr("10.0.0.100")},
+					{DisplayName: utils.Ptr("name-b"), Ip: utils.Ptr("10.0.0.2")},
+				},
+			},
+		},
+		{
+			"skips_registered_and_unknown_ips",
+			&loadbalancer.TargetPool{
+				Name: utils.Ptr("pool"),
+				ActiveHealthCheck: &loadbalancer.ActiveHealthCheck{
+					HealthyThreshold: utils.Ptr(int64(2)),
+				},
+				Targets: &[]loadbalancer.Target{
+					{DisplayName: utils.Ptr("name-a"), Ip: utils.Ptr("10.0.0.1")},
+				},
+			},
+			nil,
+			[]member{{name: "name-a", ip: "10.0.0.1"}, {name: "name-b"}},
+			&loadbalancer.UpdateTargetPoolPayload{
+				Name: utils.Ptr("pool"),
+				ActiveHealthCheck: &loadbalancer.ActiveHealthCheck{
+					HealthyThreshold: utils.Ptr(int64(2)),
+				},
+				Targets: &[]loadbalancer.Target{
+					{DisplayName: utils.Ptr("name-a"), Ip: utils.Ptr("10.0.0.1")},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toTargetPoolPayload(tt.input, tt.remove, tt.add)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNameValidators(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{"simple", "web", true},
+		{"hyphens", "example-web-1", true},
+		{"uppercase", "Web", false},
+		{"dot", "example.web", false},
+		{"underscore", "example_web", false},
+		{"leading_hyphen", "-web", false},
+		{"trailing_hyphen", "web-", false},
+		{"too_long", strings.Repeat("a", 55), false},
+	}
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewServerGroupResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	nameAttribute, ok := schemaResp.Schema.Attributes["name"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("name is not a string attribute")
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("name"),
+				ConfigValue: types.StringValue(tt.input),
+			}
+			resp := &validator.StringResponse{}
+			for _, v := range nameAttribute.Validators {
+				v.ValidateString(ctx, req, resp)
+			}
+			if !tt.isValid && !resp.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestToMemberHealthChecks(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []member
+		isValid     bool
+	}{
+		{
+			"members_with_ip",
+			[]member{{name: "web-1", ip: "10.0.0.1"}, {name: "web-2", ip: "10.0.0.2"}},
+			true,
+		},
+		{
+			"member_without_ip",
+			[]member{{name: "web-1", ip: "10.0.0.1"}, {name: "web-2"}},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toMemberHealthChecks(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && len(output) != len(tt.input) {
+				t.Fatalf("Expected %d health checks, got %d", len(tt.input), len(output))
+			}
+		})
+	}
+}
+
+func TestToHealthCheckDelay(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *loadbalancer.TargetPool
+		expected    time.Duration
+		isValid     bool
+	}{
+		{
+			"thresholds",
+			&loadbalancer.TargetPool{
+				ActiveHealthCheck: &loadbalancer.ActiveHealthCheck{
+					Interval:           utils.Ptr("5s"),
+					HealthyThreshold:   utils.Ptr(int64(2)),
+					UnhealthyThreshold: utils.Ptr(int64(3)),
+				},
+			},
+			15 * time.Second,
+			true,
+		},
+		{
+			"no_thresholds",
+			&loadbalancer.TargetPool{
+				ActiveHealthCheck: &loadbalancer.ActiveHealthCheck{
+					Interval: utils.Ptr("5s"),
+				},
+			},
+			5 * time.Second,
+			true,
+		},
+		{
+			"no_active_health_check",
+			&loadbalancer.TargetPool{
+				Name: utils.Ptr("pool"),
+			},
+			0,
+			false,
+		},
+		{
+			"invalid_interval",
+			&loadbalancer.TargetPool{
+				ActiveHealthCheck: &loadbalancer.ActiveHealthCheck{
+					Interval: utils.Ptr("five seconds"),
+				},
+			},
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toHealthCheckDelay(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && output != tt.expected {
+				t.Fatalf("Expected %s, got %s", tt.expected, output)
+			}
+		})
+	}
+}
+
+// testTargetPool returns a target pool "pool" holding targets with the given IPs
+func testTargetPool(ips ...string) []loadbalancer.TargetPool {
+	targets := []loadbalancer.Target{}
+	for _, ip := range ips {
+		targets = append(targets, loadbalancer.Target{Ip: utils.Ptr(ip)})
+	}
+	return []loadbalancer.TargetPool{
+		{
+			Name:    utils.Ptr("pool"),
+			Targets: &targets,
+		},
+	}
+}
+
+func TestUnhealthyMembers(t *testing.T) {
+	healthChecks, err := toMemberHealthChecks([]member{
+		{name: "web-1", ip: "10.0.0.1"},
+		{name: "web-2", ip: "10.0.0.2"},
+	})
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	tests := []struct {
+		description string
+		input       *loadbalancer.LoadBalancer
+		expected    []string
+	}{
+		{
+			"no_errors",
+			&loadbalancer.LoadBalancer{
+				TargetPools: utils.Ptr(testTargetPool("10.0.0.1", "10.0.0.2")),
+			},
+			[]string{},
+		},
+		{
+			"not_in_target_pool",
+			&loadbalancer.LoadBalancer{
+				TargetPools: utils.Ptr(testTargetPool("10.0.0.1")),
+			},
+			[]string{"web-2"},
+		},
+		{
+			"no_target_pool",
+			&loadbalancer.LoadBalancer{},
+			[]string{"web-1", "web-2"},
+		},
+		{
+			"inactive_target",
+			&loadbalancer.LoadBalancer{
+				TargetPools: utils.Ptr(testTargetPool("10.0.0.1", "10.0.0.2")),
+				Errors: &[]loadbalancer.LoadBalancerError{
+					{
+						Type:        loadbalancer.LOADBALANCERERRORTYPE_TARGET_NOT_ACTIVE.Ptr(),
+						Description: utils.Ptr(`Target "10.0.0.2" in target pool "pool" is not active`),
+					},
+				},
+			},
+			[]string{"web-2"},
+		},
+		{
+			"inactive_target_with_longer_ip",
+			&loadbalancer.LoadBalancer{
+				TargetPools: utils.Ptr(testTargetPool("10.0.0.1", "10.0.0.2")),
+				Errors: &[]loadbalancer.LoadBalancerError{
+					{
+						Type:        loadbalancer.LOADBALANCERERRORTYPE_TARGET_NOT_ACTIVE.Ptr(),
+						Description: utils.Ptr("Target 10.0.0.12 is not active"),
+					},
+				},
+			},
+			[]string{},
+		},
+		{
+			"other_error_type",
+			&loadbalancer.LoadBalancer{
+				TargetPools: utils.Ptr(testTargetPool("10.0.0.1", "10.0.0.2")),
+				Errors: &[]loadbalancer.LoadBalancerError{
+					{
+						Type:        loadbalancer.LOADBALANCERERRORTYPE_INTERNAL.Ptr(),
+						Description: utils.Ptr("10.0.0.1"),
+					},
+				},
+			},
+			[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := unhealthyMembers(tt.input, "pool", healthChecks)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+type getLoadBalancerClientMocked struct {
+	getLoadBalancerFails bool
+	loadBalancer         *loadbalancer.LoadBalancer
+}
+
+func (m *getLoadBalancerClientMocked) GetLoadBalancerExecute(_ context.Context, _, _, _ string) (*loadbalancer.LoadBalancer, error) {
+	if m.getLoadBalancerFails {
+		return nil, fmt.Errorf("get load balancer failed")
+	}
+	return m.loadBalancer, nil
+}
+
+func TestMembersHealthyWaitHandler(t *testing.T) {
+	healthChecks, err := toMemberHealthChecks([]member{{name: "web-1", ip: "10.0.0.1"}})
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	tests := []struct {
+		description          string
+		getLoadBalancerFails bool
+		loadBalancer         *loadbalancer.LoadBalancer
+		wantErr              bool
+	}{
+		{
+			"healthy",
+			false,
+			&loadbalancer.LoadBalancer{
+				Status:      loadbalancer.LOADBALANCERSTATUS_READY.Ptr(),
+				TargetPools: utils.Ptr(testTargetPool("10.0.0.1")),
+			},
+			false,
+		},
+		{
+			"unrelated_error",
+			false,
+			&loadbalancer.LoadBalancer{
+				Status:      loadbalancer.LOADBALANCERSTATUS_ERROR.Ptr(),
+				TargetPools: utils.Ptr(testTargetPool("10.0.0.1", "10.0.0.5")),
+				Errors: &[]loadbalancer.LoadBalancerError{
+					{
+						Type:        loadbalancer.LOADBALANCERERRORTYPE_TARGET_NOT_ACTIVE.Ptr(),
+						Description: utils.Ptr("Target 10.0.0.5 is not active"),
+					},
+				},
+			},
+			false,
+		},
+		{
+			"unhealthy",
+			false,
+			&loadbalancer.LoadBalancer{
+				Status:      loadbalancer.LOADBALANCERSTATUS_ERROR.Ptr(),
+				TargetPools: utils.Ptr(testTargetPool("10.0.0.1")),
+				Errors: &[]loadbalancer.LoadBalancerError{
+					{
+						Type:        loadbalancer.LOADBALANCERERRORTYPE_TARGET_NOT_ACTIVE.Ptr(),
+						Description: utils.Ptr("Target 10.0.0.1 is not active"),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"not_registered",
+			false,
+			&loadbalancer.LoadBalancer{
+				Status:      loadbalancer.LOADBALANCERSTATUS_READY.Ptr(),
+				TargetPools: utils.Ptr(testTargetPool()),
+			},
+			true,
+		},
+		{
+			"pending",
+			false,
+			&loadbalancer.LoadBalancer{
+				Status:      loadbalancer.LOADBALANCERSTATUS_PENDING.Ptr(),
+				TargetPools: utils.Ptr(testTargetPool("10.0.0.1")),
+			},
+			true,
+		},
+		{
+			"get_fails",
+			true,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := &getLoadBalancerClientMocked{
+				getLoadBalancerFails: tt.getLoadBalancerFails,
+				loadBalancer:         tt.loadBalancer,
+			}
+			target := &loadBalancerTarget{name: "lb", targetPoolName: "pool"}
+			handler := membersHealthyWaitHandler(context.Background(), client, "pid", "eu01", target, healthChecks, 10*time.Millisecond)
+			_, err := handler.SetThrottle(time.Millisecond).WaitWithContext(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handler error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMarkSizeDrift(t *testing.T) {
+	members := types.ListValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{})
+	tests := []struct {
+		description string
+		currentSize types.Int64
+		size        types.Int64
+		expected    bool
+	}{
+		{"no_drift", types.Int64Value(3), types.Int64Value(3), false},
+		{"members_missing", types.Int64Value(2), types.Int64Value(3), true},
+		{"size_changed", types.Int64Value(3), types.Int64Value(5), true},
+		{"size_unknown", types.Int64Value(3), types.Int64Unknown(), false},
+		{"no_current_size", types.Int64Null(), types.Int64Value(3), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			stateModel := &Model{CurrentSize: tt.currentSize, Members: members}
+			planModel := &Model{Size: tt.size, CurrentSize: tt.currentSize, Members: members}
+			output := markSizeDrift(stateModel, planModel)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+			if output && (!planModel.CurrentSize.IsUnknown() || !planModel.Members.IsUnknown()) {
+				t.Fatalf("Expected current_size and members to be unknown")
+			}
+			if !output && (!planModel.CurrentSize.Equal(tt.currentSize) || !planModel.Members.Equal(members)) {
+				t.Fatalf("Plan should not have been changed")
+			}
+		})
+	}
+}
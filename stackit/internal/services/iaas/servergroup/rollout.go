@@ -0,0 +1,509 @@
+package servergroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sdkWait "github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/loadbalancer"
+	loadbalancerWait "github.com/stackitcloud/stackit-sdk-go/services/loadbalancer/wait"
+)
+
+// member is a server which belongs to a server group
+type member struct {
+	serverId         string
+	name             string
+	availabilityZone string
+	ip               string
+	templateHash     string
+}
+
+// group holds the settings needed to roll out a server group
+type group struct {
+	projectId          string
+	name               string
+	size               int
+	maxUnavailable     int
+	gracePeriod        time.Duration
+	healthCheckTimeout time.Duration
+	template           *iaas.CreateServerPayload
+	templateHash       string
+	loadBalancer       *loadBalancerTarget
+}
+
+// loadBalancerTarget identifies the target pool the members are registered in
+type loadBalancerTarget struct {
+	name           string
+	targetPoolName string
+}
+
+// memberChanges describes how the members of a group are changed to reach the desired state
+type memberChanges struct {
+	// remove holds the members which are deleted without replacement, because the group is scaled down
+	remove []member
+	// replace holds batches of outdated members, each batch holds at most max_unavailable members
+	replace [][]member
+	// add is the number of members which are created, because the group is scaled up
+	add int
+}
+
+// planMemberChanges computes the changes needed to reach the desired size with members of the current template.
+// When scaling down, outdated members are removed first.
+func planMemberChanges(members []member, size int, templateHash string, maxUnavailable int) memberChanges {
+	if maxUnavailable < 1 {
+		maxUnavailable = 1
+	}
+
+	sorted := make([]member, len(members))
+	copy(sorted, members)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		iOutdated := sorted[i].templateHash != templateHash
+		jOutdated := sorted[j].templateHash != templateHash
+		if iOutdated != jOutdated {
+			return iOutdated
+		}
+		return sorted[i].name < sorted[j].name
+	})
+
+	changes := memberChanges{}
+	if len(sorted) > size {
+		changes.remove = sorted[:len(sorted)-size]
+		sorted = sorted[len(sorted)-size:]
+	} else {
+		changes.add = size - len(sorted)
+	}
+
+	var batch []member
+	for _, m := range sorted {
+		if m.templateHash == templateHash {
+			continue
+		}
+		batch = append(batch, m)
+		if len(batch) == maxUnavailable {
+			changes.replace = append(changes.replace, batch)
+			batch = nil
+		}
+	}
+	if len(batch) > 0 {
+		changes.replace = append(changes.replace, batch)
+	}
+	return changes
+}
+
+// rollout brings the members of the group to the desired size and template.
+// It returns the members which exist afterwards, also when an error occurred.
+func (r *serverGroupResource) rollout(ctx context.Context, g *group, existing []member, previousLoadBalancer *loadBalancerTarget) ([]member, error) {
+	members := make([]member, len(existing))
+	copy(members, existing)
+
+	if previousLoadBalancer != nil {
+		err := r.updateTargetPool(ctx, g, previousLoadBalancer, members, nil)
+		if err != nil {
+			return members, fmt.Errorf("deregistering members from previous load balancer: %w", err)
+		}
+	}
+
+	changes := planMemberChanges(members, g.size, g.templateHash, g.maxUnavailable)
+
+	if len(changes.remove) > 0 {
+		tflog.Info(ctx, "Scaling down server group", map[string]any{"count": len(changes.remove)})
+		err := r.updateTargetPool(ctx, g, g.loadBalancer, changes.remove, nil)
+		if err != nil {
+			return members, fmt.Errorf("deregistering members from load balancer: %w", err)
+		}
+		err = r.deleteMembers(ctx, g.projectId, changes.remove)
+		if err != nil {
+			return members, err
+		}
+		members = withoutMembers(members, changes.remove)
+	}
+
+	var healthCheckDelay time.Duration
+	if g.loadBalancer != nil && len(changes.replace) > 0 {
+		// Fail before the first member is replaced if the health of new members can't be checked
+		var err error
+		healthCheckDelay, err = r.loadHealthCheckDelay(ctx, g)
+		if err != nil {
+			return members, err
+		}
+	}
+
+	for i, batch := range changes.replace {
+		tflog.Info(ctx, "Replacing outdated members of server group", map[string]any{"batch": i + 1, "batches": len(changes.replace), "count": len(batch)})
+		err := r.updateTargetPool(ctx, g, g.loadBalancer, batch, nil)
+		if err != nil {
+			return members, fmt.Errorf("deregistering members from load balancer: %w", err)
+		}
+		err = r.deleteMembers(ctx, g.projectId, batch)
+		if err != nil {
+			return members, err
+		}
+		members = withoutMembers(members, batch)
+
+		created, err := r.createMembers(ctx, g, len(batch))
+		members = append(members, created...)
+		if err != nil {
+			return members, err
+		}
+		err = r.updateTargetPool(ctx, g, g.loadBalancer, nil, created)
+		if err != nil {
+			return members, fmt.Errorf("registering members in load balancer: %w", err)
+		}
+		if g.loadBalancer != nil {
+			// Don't replace further members while the new ones don't serve traffic
+			err = r.waitForHealthyMembers(ctx, g, created, healthCheckDelay)
+			if err != nil {
+				return members, fmt.Errorf("aborting rolling update: %w", err)
+			}
+		} else if i < len(changes.replace)-1 || changes.add > 0 {
+			err = waitGracePeriod(ctx, g.gracePeriod)
+			if err != nil {
+				return members, err
+			}
+		}
+	}
+
+	if changes.add > 0 {
+		tflog.Info(ctx, "Scaling up server group", map[string]any{"count": changes.add})
+		created, err := r.createMembers(ctx, g, changes.add)
+		members = append(members, created...)
+		if err != nil {
+			return members, err
+		}
+		err = r.updateTargetPool(ctx, g, g.loadBalancer, nil, created)
+		if err != nil {
+			return members, fmt.Errorf("registering members in load balancer: %w", err)
+		}
+	}
+
+	if previousLoadBalancer != nil {
+		err := r.updateTargetPool(ctx, g, g.loadBalancer, nil, members)
+		if err != nil {
+			return members, fmt.Errorf("registering members in load balancer: %w", err)
+		}
+	}
+	return members, nil
+}
+
+// createMembers creates count servers from the template of the group and waits until they are active
+func (r *serverGroupResource) createMembers(ctx context.Context, g *group, count int) ([]member, error) {
+	if g.template == nil {
+		return nil, fmt.Errorf("server template is missing")
+	}
+
+	serverIds := []string{}
+	for range count {
+		payload := toMemberPayload(g)
+		server, err := r.client.CreateServer(ctx, g.projectId).CreateServerPayload(*payload).Execute()
+		if err != nil {
+			return r.readMembers(ctx, g.projectId, serverIds), fmt.Errorf("creating server %q: %w", payload.GetName(), err)
+		}
+		serverIds = append(serverIds, server.GetId())
+	}
+
+	for _, serverId := range serverIds {
+		_, err := wait.CreateServerWaitHandler(ctx, r.client, g.projectId, serverId).WaitWithContext(ctx)
+		if err != nil {
+			return r.readMembers(ctx, g.projectId, serverIds), fmt.Errorf("server %q creation waiting: %w", serverId, err)
+		}
+	}
+	return r.readMembers(ctx, g.projectId, serverIds), nil
+}
+
+// readMembers returns the members for the given server IDs, servers which can't be read are returned without details
+func (r *serverGroupResource) readMembers(ctx context.Context, projectId string, serverIds []string) []member {
+	members := []member{}
+	for _, serverId := range serverIds {
+		server, err := r.client.GetServer(ctx, projectId, serverId).Details(true).Execute()
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Reading server %q: %v", serverId, err))
+			members = append(members, member{serverId: serverId})
+			continue
+		}
+		members = append(members, toMember(server))
+	}
+	return members
+}
+
+// deleteMembers deletes the servers of the members and waits until they are gone
+func (r *serverGroupResource) deleteMembers(ctx context.Context, projectId string, members []member) error {
+	for _, m := range members {
+		err := r.client.DeleteServer(ctx, projectId, m.serverId).Execute()
+		if err != nil {
+			var oapiErr *oapierror.GenericOpenAPIError
+			if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("deleting server %q: %w", m.serverId, err)
+		}
+	}
+	for _, m := range members {
+		_, err := wait.DeleteServerWaitHandler(ctx, r.client, projectId, m.serverId).WaitWithContext(ctx)
+		if err != nil {
+			return fmt.Errorf("server %q deletion waiting: %w", m.serverId, err)
+		}
+	}
+	return nil
+}
+
+// updateTargetPool deregisters and registers members in the target pool and waits until the load balancer is ready again
+func (r *serverGroupResource) updateTargetPool(ctx context.Context, g *group, lb *loadBalancerTarget, remove, add []member) error {
+	if lb == nil || (len(remove) == 0 && len(add) == 0) {
+		return nil
+	}
+	region := r.providerData.GetRegion()
+
+	lbResp, err := r.lbClient.GetLoadBalancerExecute(ctx, g.projectId, region, lb.name)
+	if err != nil {
+		return fmt.Errorf("reading load balancer %q: %w", lb.name, err)
+	}
+	targetPool := findTargetPool(lbResp, lb.targetPoolName)
+	if targetPool == nil {
+		return fmt.Errorf("target pool %q not found in load balancer %q", lb.targetPoolName, lb.name)
+	}
+
+	payload := toTargetPoolPayload(targetPool, remove, add)
+	_, err = r.lbClient.UpdateTargetPool(ctx, g.projectId, region, lb.name, lb.targetPoolName).UpdateTargetPoolPayload(*payload).Execute()
+	if err != nil {
+		return fmt.Errorf("updating target pool %q: %w", lb.targetPoolName, err)
+	}
+	_, err = loadbalancerWait.CreateLoadBalancerWaitHandler(ctx, r.lbClient, g.projectId, region, lb.name).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("load balancer %q waiting: %w", lb.name, err)
+	}
+	return nil
+}
+
+// toMemberPayload builds the payload of a new member from the template of the group
+func toMemberPayload(g *group) *iaas.CreateServerPayload {
+	payload := *g.template
+	name := fmt.Sprintf("%s-%s", g.name, uuid.NewString()[:8])
+	payload.Name = &name
+
+	labels := map[string]interface{}{}
+	for key, value := range g.template.GetLabels() {
+		labels[key] = value
+	}
+	labels[groupLabel] = g.name
+	labels[templateHashLabel] = g.templateHash
+	payload.Labels = &labels
+	return &payload
+}
+
+// toTargetPoolPayload removes and adds the members to the targets of the pool. Other targets of the pool are kept.
+func toTargetPoolPayload(targetPool *loadbalancer.TargetPool, remove, add []member) *loadbalancer.UpdateTargetPoolPayload {
+	removed := map[string]bool{}
+	for _, m := range remove {
+		if m.ip != "" {
+			removed[m.ip] = true
+		}
+	}
+
+	targets := []loadbalancer.Target{}
+	present := map[string]bool{}
+	for _, target := range targetPool.GetTargets() {
+		if removed[target.GetIp()] {
+			continue
+		}
+		present[target.GetIp()] = true
+		targets = append(targets, target)
+	}
+	for _, m := range add {
+		if m.ip == "" || present[m.ip] {
+			continue
+		}
+		present[m.ip] = true
+		targets = append(targets, loadbalancer.Target{
+			DisplayName: loadbalancer.PtrString(m.name),
+			Ip:          loadbalancer.PtrString(m.ip),
+		})
+	}
+
+	return &loadbalancer.UpdateTargetPoolPayload{
+		ActiveHealthCheck:  targetPool.ActiveHealthCheck,
+		Name:               targetPool.Name,
+		SessionPersistence: targetPool.SessionPersistence,
+		TargetPort:         targetPool.TargetPort,
+		Targets:            &targets,
+	}
+}
+
+func withoutMembers(members, remove []member) []member {
+	removed := map[string]bool{}
+	for _, m := range remove {
+		removed[m.serverId] = true
+	}
+	result := []member{}
+	for _, m := range members {
+		if !removed[m.serverId] {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// getLoadBalancerClient is the part of the load balancer API used to check the health of members
+type getLoadBalancerClient interface {
+	GetLoadBalancerExecute(ctx context.Context, projectId, region, name string) (*loadbalancer.LoadBalancer, error)
+}
+
+// loadHealthCheckDelay returns the time the active health check of the target pool of the group needs to judge a new target
+func (r *serverGroupResource) loadHealthCheckDelay(ctx context.Context, g *group) (time.Duration, error) {
+	lb, err := r.lbClient.GetLoadBalancerExecute(ctx, g.projectId, r.providerData.GetRegion(), g.loadBalancer.name)
+	if err != nil {
+		return 0, fmt.Errorf("reading load balancer %q: %w", g.loadBalancer.name, err)
+	}
+	targetPool := findTargetPool(lb, g.loadBalancer.targetPoolName)
+	if targetPool == nil {
+		return 0, fmt.Errorf("target pool %q not found in load balancer %q", g.loadBalancer.targetPoolName, g.loadBalancer.name)
+	}
+	return toHealthCheckDelay(targetPool)
+}
+
+// waitForHealthyMembers waits for the grace period and the health check delay of the target pool
+// and then until the load balancer reports the members as healthy
+func (r *serverGroupResource) waitForHealthyMembers(ctx context.Context, g *group, members []member, healthCheckDelay time.Duration) error {
+	healthChecks, err := toMemberHealthChecks(members)
+	if err != nil {
+		return err
+	}
+
+	// The load balancer doesn't report anything about targets it hasn't probed yet
+	err = waitGracePeriod(ctx, g.gracePeriod+healthCheckDelay)
+	if err != nil {
+		return err
+	}
+	tflog.Info(ctx, "Waiting for new members of server group to become healthy", map[string]any{"count": len(members)})
+	region := r.providerData.GetRegion()
+	_, err = membersHealthyWaitHandler(ctx, r.lbClient, g.projectId, region, g.loadBalancer, healthChecks, g.healthCheckTimeout).WaitWithContext(ctx)
+	if err != nil {
+		// Name the unhealthy members to ease debugging of the template
+		if lb, getErr := r.lbClient.GetLoadBalancerExecute(ctx, g.projectId, region, g.loadBalancer.name); getErr == nil {
+			if unhealthy := unhealthyMembers(lb, g.loadBalancer.targetPoolName, healthChecks); len(unhealthy) > 0 {
+				return fmt.Errorf("members %v didn't become healthy in load balancer %q: %w", unhealthy, g.loadBalancer.name, err)
+			}
+		}
+		return fmt.Errorf("members didn't become healthy in load balancer %q: %w", g.loadBalancer.name, err)
+	}
+	return nil
+}
+
+// memberHealthCheck matches the load balancer errors which concern a member
+type memberHealthCheck struct {
+	name string
+	ip   string
+	// ipPattern matches the IP of the member in the description of load balancer errors
+	ipPattern *regexp.Regexp
+}
+
+// toMemberHealthChecks prepares the health checks of the members, all members must have an IP
+func toMemberHealthChecks(members []member) ([]memberHealthCheck, error) {
+	healthChecks := []memberHealthCheck{}
+	for _, m := range members {
+		if m.ip == "" {
+			return nil, fmt.Errorf("member %q has no IP, its health can't be checked in the load balancer", m.name)
+		}
+		healthChecks = append(healthChecks, memberHealthCheck{
+			name:      m.name,
+			ip:        m.ip,
+			ipPattern: regexp.MustCompile(`(^|[^0-9.])` + regexp.QuoteMeta(m.ip) + `($|[^0-9])`),
+		})
+	}
+	return healthChecks, nil
+}
+
+// toHealthCheckDelay returns the time the active health check of the target pool needs to judge a new target
+func toHealthCheckDelay(targetPool *loadbalancer.TargetPool) (time.Duration, error) {
+	healthCheck, ok := targetPool.GetActiveHealthCheckOk()
+	if !ok || healthCheck.GetInterval() == "" {
+		return 0, fmt.Errorf("target pool %q has no active health check, the health of new members can't be checked", targetPool.GetName())
+	}
+	interval, err := time.ParseDuration(healthCheck.GetInterval())
+	if err != nil {
+		return 0, fmt.Errorf("parsing health check interval of target pool %q: %w", targetPool.GetName(), err)
+	}
+	// wait for enough probes to reach either threshold, but at least one interval
+	probes := max(healthCheck.GetHealthyThreshold(), healthCheck.GetUnhealthyThreshold(), 1)
+	return time.Duration(probes) * interval, nil
+}
+
+// membersHealthyWaitHandler waits until the members are targets of the pool and the load balancer doesn't report any of them as inactive
+func membersHealthyWaitHandler(ctx context.Context, a getLoadBalancerClient, projectId, region string, target *loadBalancerTarget, healthChecks []memberHealthCheck, timeout time.Duration) *sdkWait.AsyncActionHandler[loadbalancer.LoadBalancer] {
+	handler := sdkWait.New(func() (waitFinished bool, response *loadbalancer.LoadBalancer, err error) {
+		lb, err := a.GetLoadBalancerExecute(ctx, projectId, region, target.name)
+		if err != nil {
+			return false, lb, err
+		}
+		if lb == nil {
+			return true, nil, fmt.Errorf("load balancer %q not found", target.name)
+		}
+		unhealthy := unhealthyMembers(lb, target.targetPoolName, healthChecks)
+		if len(unhealthy) > 0 {
+			tflog.Debug(ctx, "Members of server group are not healthy yet", map[string]any{"members": unhealthy})
+			return false, lb, nil
+		}
+		return lb.GetStatus() != loadbalancer.LOADBALANCERSTATUS_PENDING, lb, nil
+	})
+	handler.SetTimeout(timeout)
+	return handler
+}
+
+// unhealthyMembers returns the names of the members which aren't targets of the pool
+// or for which the load balancer reports an inactive target.
+// The load balancer API doesn't report the health per target, so the IPs are matched in the error descriptions.
+func unhealthyMembers(lb *loadbalancer.LoadBalancer, targetPoolName string, healthChecks []memberHealthCheck) []string {
+	targetIps := map[string]bool{}
+	if targetPool := findTargetPool(lb, targetPoolName); targetPool != nil {
+		for _, target := range targetPool.GetTargets() {
+			targetIps[target.GetIp()] = true
+		}
+	}
+
+	unhealthy := []string{}
+	for _, hc := range healthChecks {
+		if !targetIps[hc.ip] {
+			unhealthy = append(unhealthy, hc.name)
+			continue
+		}
+		for _, lbErr := range lb.GetErrors() {
+			if lbErr.GetType() == loadbalancer.LOADBALANCERERRORTYPE_TARGET_NOT_ACTIVE && hc.ipPattern.MatchString(lbErr.GetDescription()) {
+				unhealthy = append(unhealthy, hc.name)
+				break
+			}
+		}
+	}
+	return unhealthy
+}
+
+// findTargetPool returns the target pool of the load balancer with the given name, or nil if it doesn't exist
+func findTargetPool(lb *loadbalancer.LoadBalancer, name string) *loadbalancer.TargetPool {
+	for i := range lb.GetTargetPools() {
+		if lb.GetTargetPools()[i].GetName() == name {
+			return &lb.GetTargetPools()[i]
+		}
+	}
+	return nil
+}
+
+// waitGracePeriod waits before the health of new members is checked, or between batches if no load balancer is used
+func waitGracePeriod(ctx context.Context, gracePeriod time.Duration) error {
+	if gracePeriod <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(gracePeriod):
+		return nil
+	}
+}
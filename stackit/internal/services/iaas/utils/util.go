@@ -3,6 +3,9 @@ package utils
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -14,6 +17,11 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 )
 
+const (
+	EtherTypeIPv4 = "IPv4"
+	EtherTypeIPv6 = "IPv6"
+)
+
 func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags *diag.Diagnostics) *iaas.APIClient {
 	apiClientConfigOptions := []config.ConfigurationOption{
 		config.WithCustomAuth(providerData.RoundTripper),
@@ -51,3 +59,36 @@ func MapLabels(ctx context.Context, responseLabels *map[string]interface{}, curr
 
 	return labelsTF, nil
 }
+
+// LabelSelector builds the label selector for list requests of the IaaS API from a labels map, e.g. "key1=value1,key2=value2".
+// Returns an empty string if no labels are set.
+func LabelSelector(ctx context.Context, labels types.Map) (string, error) {
+	if labels.IsNull() || labels.IsUnknown() {
+		return "", nil
+	}
+	labelsMap := map[string]string{}
+	diags := labels.ElementsAs(ctx, &labelsMap, false)
+	if diags.HasError() {
+		return "", fmt.Errorf("converting labels: %w", core.DiagsToError(diags))
+	}
+
+	selectors := make([]string, 0, len(labelsMap))
+	for key, value := range labelsMap {
+		selectors = append(selectors, fmt.Sprintf("%s=%s", key, value))
+	}
+	// Sort to get a stable selector
+	sort.Strings(selectors)
+	return strings.Join(selectors, ","), nil
+}
+
+// EtherType returns the ether type of an IP range in CIDR notation or of an IP address, "IPv6" for IPv6 and "IPv4" otherwise.
+func EtherType(ipRange string) string {
+	addr, err := netip.ParseAddr(ipRange)
+	if prefix, prefixErr := netip.ParsePrefix(ipRange); prefixErr == nil {
+		addr, err = prefix.Addr(), nil
+	}
+	if err == nil && addr.Is6() {
+		return EtherTypeIPv6
+	}
+	return EtherTypeIPv4
+}
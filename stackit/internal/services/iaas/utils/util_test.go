@@ -177,3 +177,83 @@ func TestMapLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestLabelSelector(t *testing.T) {
+	tests := []struct {
+		description string
+		input       types.Map
+		expected    string
+	}{
+		{
+			"null",
+			types.MapNull(types.StringType),
+			"",
+		},
+		{
+			"empty",
+			types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			"",
+		},
+		{
+			"multiple_labels",
+			types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env":  types.StringValue("prod"),
+				"team": types.StringValue("network"),
+			}),
+			"env=prod,team=network",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := LabelSelector(context.Background(), tt.input)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestEtherType(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    string
+	}{
+		{
+			"ipv4",
+			"10.0.0.0/24",
+			EtherTypeIPv4,
+		},
+		{
+			"ipv6",
+			"2001:db8::/64",
+			EtherTypeIPv6,
+		},
+		{
+			"ipv6_any",
+			"::/0",
+			EtherTypeIPv6,
+		},
+		{
+			"ipv6_address",
+			"2001:db8::1",
+			EtherTypeIPv6,
+		},
+		{
+			"invalid",
+			"foo",
+			EtherTypeIPv4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := EtherType(tt.input)
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
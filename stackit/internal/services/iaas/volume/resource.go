@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -24,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sdkWait "github.com/stackitcloud/stackit-sdk-go/core/wait"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
@@ -41,6 +44,11 @@ var (
 	SupportedSourceTypes = []string{"volume", "image", "snapshot", "backup"}
 )
 
+const (
+	volumeAttachedStatus      = "ATTACHED"
+	volumeErrorResizingStatus = "ERROR_RESIZING"
+)
+
 type Model struct {
 	Id               types.String `tfsdk:"id"` // needed by TF
 	ProjectId        types.String `tfsdk:"project_id"`
@@ -194,7 +202,7 @@ func (r *volumeResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional:    true,
 			},
 			"performance_class": schema.StringAttribute{
-				MarkdownDescription: "The performance class of the volume. Possible values are documented in [Service plans BlockStorage](https://docs.stackit.cloud/stackit/en/service-plans-blockstorage-75137974.html#ServiceplansBlockStorage-CurrentlyavailableServicePlans%28performanceclasses%29)",
+				MarkdownDescription: "The performance class of the volume. Possible values are documented in [Service plans BlockStorage](https://docs.stackit.cloud/stackit/en/service-plans-blockstorage-75137974.html#ServiceplansBlockStorage-CurrentlyavailableServicePlans%28performanceclasses%29). The API doesn't support changing the performance class of an existing volume, so changing it replaces the volume.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -210,10 +218,11 @@ func (r *volumeResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"size": schema.Int64Attribute{
-				Description: "The size of the volume in GB. It can only be updated to a larger value than the current size. Either `size` or `source` must be provided",
+				Description: "The size of the volume in GB. It can only be updated to a larger value than the current size, also while the volume is attached to a server. Either `size` or `source` must be provided",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 					volumeResizeModifier{},
 				},
 			},
@@ -261,19 +270,12 @@ func (v volumeResizeModifier) MarkdownDescription(ctx context.Context) string {
 
 // PlanModifyInt64 implements planmodifier.Int64.
 func (v volumeResizeModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) { // nolint:gocritic // function signature required by Terraform
-	var planSize types.Int64
-	var currentSize types.Int64
-
-	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("size"), &planSize)...)
-	if resp.Diagnostics.HasError() {
+	// Nothing to compare on creation or if the size isn't known yet
+	if utils.IsUndefined(req.PlanValue) || utils.IsUndefined(req.StateValue) {
 		return
 	}
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("size"), &currentSize)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	if planSize.ValueInt64() < currentSize.ValueInt64() {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error changing volume size", "A volume cannot be made smaller in order to prevent data loss.")
+	if req.PlanValue.ValueInt64() < req.StateValue.ValueInt64() {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error changing volume size", fmt.Sprintf("A volume cannot be made smaller in order to prevent data loss. The planned size (%d GB) is smaller than the current size (%d GB).", req.PlanValue.ValueInt64(), req.StateValue.ValueInt64()))
 	}
 }
 
@@ -418,6 +420,7 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		// A volume can only be resized to larger values, otherwise an error occurs
 		if *modelSize < *updatedVolume.Size {
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating volume", fmt.Sprintf("The new volume size must be larger than the current size (%d GB)", *updatedVolume.Size))
+			return
 		} else if *modelSize > *updatedVolume.Size {
 			payload := iaas.ResizeVolumePayload{
 				Size: modelSize,
@@ -425,9 +428,14 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 			err := r.client.ResizeVolume(ctx, projectId, volumeId).ResizeVolumePayload(payload).Execute()
 			if err != nil {
 				core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating volume", fmt.Sprintf("Resizing the volume, calling API: %v", err))
+				return
+			}
+			// The API doesn't return a volume object as response, the resized volume is returned by the wait handler
+			updatedVolume, err = resizeVolumeWaitHandler(ctx, r.client, projectId, volumeId, *modelSize).WaitWithContext(ctx)
+			if err != nil {
+				core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating volume", fmt.Sprintf("volume resize waiting: %v", err))
+				return
 			}
-			// Update volume model because the API doesn't return a volume object as response
-			updatedVolume.Size = modelSize
 		}
 	}
 	err = mapFields(ctx, updatedVolume, &model)
@@ -598,3 +606,31 @@ func toUpdatePayload(ctx context.Context, model *Model, currentLabels types.Map)
 		Labels:      &labels,
 	}, nil
 }
+
+type getVolumeClient interface {
+	GetVolumeExecute(ctx context.Context, projectId string, volumeId string) (*iaas.Volume, error)
+}
+
+// resizeVolumeWaitHandler waits until the volume has the given size. Attached volumes are extended online,
+// so the volume is either available or attached once the resize is done.
+func resizeVolumeWaitHandler(ctx context.Context, a getVolumeClient, projectId, volumeId string, size int64) *sdkWait.AsyncActionHandler[iaas.Volume] {
+	handler := sdkWait.New(func() (waitFinished bool, response *iaas.Volume, err error) {
+		volume, err := a.GetVolumeExecute(ctx, projectId, volumeId)
+		if err != nil {
+			return false, volume, err
+		}
+		if volume.Size == nil || volume.Status == nil {
+			return false, volume, fmt.Errorf("resize failed for volume with id %s, the response is not valid: the size or the status are missing", volumeId)
+		}
+		switch *volume.Status {
+		case wait.ErrorStatus, volumeErrorResizingStatus:
+			return true, volume, fmt.Errorf("resize failed for volume with id %s, status %s", volumeId, *volume.Status)
+		case wait.VolumeAvailableStatus, volumeAttachedStatus:
+			return *volume.Size == size, volume, nil
+		default:
+			return false, volume, nil
+		}
+	})
+	handler.SetTimeout(30 * time.Minute)
+	return handler
+}
@@ -2,7 +2,9 @@ package volume
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -251,3 +253,88 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+type getVolumeClientMocked struct {
+	getVolumeFails bool
+	volume         *iaas.Volume
+}
+
+func (m *getVolumeClientMocked) GetVolumeExecute(_ context.Context, _, _ string) (*iaas.Volume, error) {
+	if m.getVolumeFails {
+		return nil, fmt.Errorf("get volume failed")
+	}
+	return m.volume, nil
+}
+
+func TestResizeVolumeWaitHandler(t *testing.T) {
+	tests := []struct {
+		description    string
+		getVolumeFails bool
+		volume         *iaas.Volume
+		wantErr        bool
+	}{
+		{
+			"available",
+			false,
+			&iaas.Volume{
+				Size:   utils.Ptr(int64(20)),
+				Status: utils.Ptr("AVAILABLE"),
+			},
+			false,
+		},
+		{
+			"attached",
+			false,
+			&iaas.Volume{
+				Size:   utils.Ptr(int64(20)),
+				Status: utils.Ptr("ATTACHED"),
+			},
+			false,
+		},
+		{
+			"resize_failed",
+			false,
+			&iaas.Volume{
+				Size:   utils.Ptr(int64(10)),
+				Status: utils.Ptr("ERROR_RESIZING"),
+			},
+			true,
+		},
+		{
+			"still_old_size",
+			false,
+			&iaas.Volume{
+				Size:   utils.Ptr(int64(10)),
+				Status: utils.Ptr("AVAILABLE"),
+			},
+			true,
+		},
+		{
+			"status_missing",
+			false,
+			&iaas.Volume{
+				Size: utils.Ptr(int64(20)),
+			},
+			true,
+		},
+		{
+			"get_fails",
+			true,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := &getVolumeClientMocked{
+				getVolumeFails: tt.getVolumeFails,
+				volume:         tt.volume,
+			}
+			handler := resizeVolumeWaitHandler(context.Background(), client, "pid", "vid", 20)
+			_, err := handler.SetTimeout(10 * time.Millisecond).SetThrottle(time.Millisecond).WaitWithContext(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handler error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 
@@ -32,6 +33,10 @@ var (
 	_ resource.Resource                = &volumeAttachResource{}
 	_ resource.ResourceWithConfigure   = &volumeAttachResource{}
 	_ resource.ResourceWithImportState = &volumeAttachResource{}
+
+	// serverLocks holds a mutex per server. Attaching several volumes to the same server in parallel
+	// can fail, so attachments and removals are serialised per server.
+	serverLocks sync.Map
 )
 
 type Model struct {
@@ -73,7 +78,7 @@ func (r *volumeAttachResource) Configure(ctx context.Context, req resource.Confi
 
 // Schema defines the schema for the resource.
 func (r *volumeAttachResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	description := "Volume attachment resource schema. Attaches a volume to a server. Must have a `region` specified in the provider configuration."
+	description := "Volume attachment resource schema. Attaches a volume to a server. Volumes can only be attached to one server at a time. Attachments to the same server are done one after another. Must have a `region` specified in the provider configuration."
 	resp.Schema = schema.Schema{
 		MarkdownDescription: description,
 		Description:         description,
@@ -139,12 +144,26 @@ func (r *volumeAttachResource) Create(ctx context.Context, req resource.CreateRe
 	volumeId := model.VolumeId.ValueString()
 	ctx = tflog.SetField(ctx, "volume_id", volumeId)
 
+	unlock := lockServer(serverId)
+	defer unlock()
+
+	volume, err := r.client.GetVolume(ctx, projectId, volumeId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error attaching volume to server", fmt.Sprintf("Calling API to get volume: %v", err))
+		return
+	}
+	err = checkVolumeAttachable(volume, serverId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error attaching volume to server", err.Error())
+		return
+	}
+
 	// Create new Volume attachment
 
 	payload := iaas.AddVolumeToServerPayload{
 		DeleteOnTermination: sdkUtils.Ptr(false),
 	}
-	_, err := r.client.AddVolumeToServer(ctx, projectId, serverId, volumeId).AddVolumeToServerPayload(payload).Execute()
+	_, err = r.client.AddVolumeToServer(ctx, projectId, serverId, volumeId).AddVolumeToServerPayload(payload).Execute()
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error attaching volume to server", fmt.Sprintf("Calling API: %v", err))
 		return
@@ -224,6 +243,9 @@ func (r *volumeAttachResource) Delete(ctx context.Context, req resource.DeleteRe
 	volumeId := model.VolumeId.ValueString()
 	ctx = tflog.SetField(ctx, "volume_id", volumeId)
 
+	unlock := lockServer(serverId)
+	defer unlock()
+
 	// Remove volume from server
 	err := r.client.RemoveVolumeFromServer(ctx, projectId, serverId, volumeId).Execute()
 	if err != nil {
@@ -265,3 +287,23 @@ func (r *volumeAttachResource) ImportState(ctx context.Context, req resource.Imp
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume_id"), volumeId)...)
 	tflog.Info(ctx, "Volume attachment state imported")
 }
+
+// lockServer locks the mutex of the given server and returns the function to unlock it.
+func lockServer(serverId string) func() {
+	lock, _ := serverLocks.LoadOrStore(serverId, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+// checkVolumeAttachable returns an error if the volume is already attached to another server.
+func checkVolumeAttachable(volume *iaas.Volume, serverId string) error {
+	if volume == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	attachedServerId := volume.GetServerId()
+	if attachedServerId == "" || attachedServerId == serverId {
+		return nil
+	}
+	return fmt.Errorf("volume %q is already attached to server %q. A volume can only be attached to one server at a time, it must be detached first", volume.GetId(), attachedServerId)
+}
@@ -0,0 +1,61 @@
+package volumeattach
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestCheckVolumeAttachable(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.Volume
+		serverId    string
+		isValid     bool
+	}{
+		{
+			"not_attached",
+			&iaas.Volume{
+				Id: utils.Ptr("vid"),
+			},
+			"sid",
+			true,
+		},
+		{
+			"attached_to_same_server",
+			&iaas.Volume{
+				Id:       utils.Ptr("vid"),
+				ServerId: utils.Ptr("sid"),
+			},
+			"sid",
+			true,
+		},
+		{
+			"attached_to_other_server",
+			&iaas.Volume{
+				Id:       utils.Ptr("vid"),
+				ServerId: utils.Ptr("other-sid"),
+			},
+			"sid",
+			false,
+		},
+		{
+			"nil_volume",
+			nil,
+			"sid",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := checkVolumeAttachable(tt.input, tt.serverId)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"strings"
 	"time"
 
@@ -337,7 +338,7 @@ func (r *loadBalancerResource) Schema(_ context.Context, _ resource.SchemaReques
 		"target_port":                           "Identical port number where each target listens for traffic.",
 		"targets":                               "List of all targets which will be used in the pool. Limited to 1000.",
 		"targets.display_name":                  "Target display name",
-		"ip":                                    "Target IP",
+		"ip":                                    "Target IP, either an IPv4 or an IPv6 address.",
 		"region":                                "The resource region. If not defined, the provider region is used.",
 	}
 
@@ -372,6 +373,9 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					validate.IP(false),
+				},
 			},
 			"plan_id": schema.StringAttribute{
 				Description: descriptions["plan_id"],
@@ -653,6 +657,9 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 									"ip": schema.StringAttribute{
 										Description: descriptions["ip"],
 										Required:    true,
+										Validators: []validator.String{
+											validate.IP(false),
+										},
 									},
 								},
 							},
@@ -1219,7 +1226,7 @@ func mapFields(ctx context.Context, lb *loadbalancer.LoadBalancer, m *Model, reg
 	m.Id = utils.BuildInternalTerraformId(m.ProjectId.ValueString(), m.Region.ValueString(), name)
 
 	m.PlanId = types.StringPointerValue(lb.PlanId)
-	m.ExternalAddress = types.StringPointerValue(lb.ExternalAddress)
+	m.ExternalAddress = keepAddressNotation(lb.ExternalAddress, m.ExternalAddress.ValueString())
 	m.PrivateAddress = types.StringPointerValue(lb.PrivateAddress)
 
 	err := mapListeners(lb, m)
@@ -1234,7 +1241,7 @@ func mapFields(ctx context.Context, lb *loadbalancer.LoadBalancer, m *Model, reg
 	if err != nil {
 		return fmt.Errorf("mapping options: %w", err)
 	}
-	err = mapTargetPools(lb, m)
+	err = mapTargetPools(ctx, lb, m)
 	if err != nil {
 		return fmt.Errorf("mapping target pools: %w", err)
 	}
@@ -1443,12 +1450,17 @@ func mapACL(accessControlResp *loadbalancer.LoadbalancerOptionAccessControl, o m
 	return nil
 }
 
-func mapTargetPools(loadBalancerResp *loadbalancer.LoadBalancer, m *Model) error {
+func mapTargetPools(ctx context.Context, loadBalancerResp *loadbalancer.LoadBalancer, m *Model) error {
 	if loadBalancerResp.TargetPools == nil {
 		m.TargetPools = types.ListNull(types.ObjectType{AttrTypes: targetPoolTypes})
 		return nil
 	}
 
+	configuredTargetIps, err := targetIps(ctx, m.TargetPools)
+	if err != nil {
+		return fmt.Errorf("reading target IPs of the model: %w", err)
+	}
+
 	targetPoolsList := []attr.Value{}
 	for i, targetPoolResp := range *loadBalancerResp.TargetPools {
 		targetPoolMap := map[string]attr.Value{
@@ -1461,7 +1473,7 @@ func mapTargetPools(loadBalancerResp *loadbalancer.LoadBalancer, m *Model) error
 			return fmt.Errorf("mapping index %d, field ActiveHealthCheck: %w", i, err)
 		}
 
-		err = mapTargets(targetPoolResp.Targets, targetPoolMap)
+		err = mapTargets(targetPoolResp.Targets, configuredTargetIps, targetPoolMap)
 		if err != nil {
 			return fmt.Errorf("mapping index %d, field Targets: %w", i, err)
 		}
@@ -1514,7 +1526,7 @@ func mapActiveHealthCheck(activeHealthCheckResp *loadbalancer.ActiveHealthCheck,
 	return nil
 }
 
-func mapTargets(targetsResp *[]loadbalancer.Target, tp map[string]attr.Value) error {
+func mapTargets(targetsResp *[]loadbalancer.Target, configuredIps []string, tp map[string]attr.Value) error {
 	if targetsResp == nil || *targetsResp == nil {
 		tp["targets"] = types.ListNull(types.ObjectType{AttrTypes: targetTypes})
 		return nil
@@ -1524,7 +1536,7 @@ func mapTargets(targetsResp *[]loadbalancer.Target, tp map[string]attr.Value) er
 	for i, targetResp := range *targetsResp {
 		targetMap := map[string]attr.Value{
 			"display_name": types.StringPointerValue(targetResp.DisplayName),
-			"ip":           types.StringPointerValue(targetResp.Ip),
+			"ip":           keepAddressNotation(targetResp.Ip, configuredIps...),
 		}
 
 		targetTF, diags := types.ObjectValue(targetTypes, targetMap)
@@ -1565,3 +1577,49 @@ func mapSessionPersistence(sessionPersistenceResp *loadbalancer.SessionPersisten
 	tp["session_persistence"] = sessionPersistenceTF
 	return nil
 }
+
+// targetIps returns the IPs of all targets of the target pools
+func targetIps(ctx context.Context, targetPools types.List) ([]string, error) {
+	if targetPools.IsNull() || targetPools.IsUnknown() {
+		return nil, nil
+	}
+	pools := []targetPool{}
+	diags := targetPools.ElementsAs(ctx, &pools, false)
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	ips := []string{}
+	for i := range pools {
+		if pools[i].Targets.IsNull() || pools[i].Targets.IsUnknown() {
+			continue
+		}
+		targets := []target{}
+		diags := pools[i].Targets.ElementsAs(ctx, &targets, false)
+		if diags.HasError() {
+			return nil, core.DiagsToError(diags)
+		}
+		for j := range targets {
+			ips = append(ips, targets[j].Ip.ValueString())
+		}
+	}
+	return ips, nil
+}
+
+// keepAddressNotation returns the configured notation of an IP address if it's equal to the address returned by the API.
+// IPv6 addresses can be written in different notations, the API returns them in the canonical one.
+func keepAddressNotation(address *string, configured ...string) types.String {
+	if address == nil {
+		return types.StringNull()
+	}
+	respAddress, err := netip.ParseAddr(*address)
+	if err == nil {
+		for _, c := range configured {
+			configuredAddress, err := netip.ParseAddr(c)
+			if err == nil && configuredAddress == respAddress {
+				return types.StringValue(c)
+			}
+		}
+	}
+	return types.StringValue(*address)
+}
@@ -917,3 +917,52 @@ func Test_validateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestKeepAddressNotation(t *testing.T) {
+	tests := []struct {
+		description string
+		address     *string
+		configured  []string
+		expected    types.String
+	}{
+		{
+			"nil_address",
+			nil,
+			[]string{"10.0.0.1"},
+			types.StringNull(),
+		},
+		{
+			"ipv4",
+			utils.Ptr("10.0.0.1"),
+			[]string{"10.0.0.1"},
+			types.StringValue("10.0.0.1"),
+		},
+		{
+			"ipv6_configured_notation_kept",
+			utils.Ptr("2001:db8::1"),
+			[]string{"10.0.0.1", "2001:DB8:0:0::0001"},
+			types.StringValue("2001:DB8:0:0::0001"),
+		},
+		{
+			"ipv6_not_configured",
+			utils.Ptr("2001:db8::1"),
+			[]string{"2001:db8::2"},
+			types.StringValue("2001:db8::1"),
+		},
+		{
+			"nothing_configured",
+			utils.Ptr("2001:db8::1"),
+			nil,
+			types.StringValue("2001:db8::1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := keepAddressNotation(tt.address, tt.configured...)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
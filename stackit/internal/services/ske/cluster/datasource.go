@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -24,6 +26,22 @@ var (
 	_ datasource.DataSource = &clusterDataSource{}
 )
 
+// DataSourceModel extends the cluster model with fields only available in the data source.
+type DataSourceModel struct {
+	Model
+	RotationPhase types.String `tfsdk:"rotation_phase"`
+	LastCompleted types.String `tfsdk:"last_completed"`
+	Status        types.String `tfsdk:"status"`
+	Hibernated    types.Bool   `tfsdk:"hibernated"`
+	Errors        types.List   `tfsdk:"errors"`
+}
+
+// Types corresponding to DataSourceModel.Errors
+var clusterErrorTypes = map[string]attr.Type{
+	"code":    types.StringType,
+	"message": types.StringType,
+}
+
 // NewClusterDataSource is a helper function to simplify the provider implementation.
 func NewClusterDataSource() datasource.DataSource {
 	return &clusterDataSource{}
@@ -93,6 +111,38 @@ func (r *clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"rotation_phase": schema.StringAttribute{
+				Description: "Phase of the credentials rotation of the cluster CA and service account keys. `NEVER` indicates that no credentials rotation has been performed yet.",
+				Computed:    true,
+			},
+			"last_completed": schema.StringAttribute{
+				Description: "Date-time when the last credentials rotation was completed.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Aggregated status of the cluster, e.g. `STATE_RECONCILING` while a maintenance or reconciliation is in progress.",
+				Computed:    true,
+			},
+			"hibernated": schema.BoolAttribute{
+				Description: "Whether the cluster is currently hibernated.",
+				Computed:    true,
+			},
+			"errors": schema.ListNestedAttribute{
+				Description: "Errors reported for the cluster, e.g. quota or infrastructure problems.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Description: "Error code.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "Error message.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"node_pools": schema.ListNestedAttribute{
 				Description: "One or more `node_pool` block as defined below.",
 				Computed:    true,
@@ -323,7 +373,7 @@ func (r *clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 
 // Read refreshes the Terraform state with the latest data.
 func (r *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var state Model
+	var state DataSourceModel
 	diags := req.Config.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -352,7 +402,7 @@ func (r *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	err = mapFields(ctx, clusterResp, &state, region)
+	err = mapDataSourceFields(ctx, clusterResp, &state, region)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading cluster", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -366,3 +416,63 @@ func (r *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 	tflog.Info(ctx, "SKE cluster read")
 }
+
+func mapDataSourceFields(ctx context.Context, cl *ske.Cluster, m *DataSourceModel, region string) error {
+	if m == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	err := mapFields(ctx, cl, &m.Model, region)
+	if err != nil {
+		return err
+	}
+
+	m.Status = types.StringNull()
+	m.Hibernated = types.BoolNull()
+	if cl.Status != nil {
+		if cl.Status.Aggregated != nil {
+			m.Status = types.StringValue(string(*cl.Status.Aggregated))
+		}
+		m.Hibernated = types.BoolPointerValue(cl.Status.Hibernated)
+	}
+	err = mapClusterErrors(cl, m)
+	if err != nil {
+		return fmt.Errorf("mapping errors: %w", err)
+	}
+
+	m.RotationPhase = types.StringNull()
+	m.LastCompleted = types.StringNull()
+	if cl.Status != nil && cl.Status.CredentialsRotation != nil {
+		rotation := cl.Status.CredentialsRotation
+		if rotation.Phase != nil {
+			m.RotationPhase = types.StringValue(string(*rotation.Phase))
+		}
+		if rotation.LastCompletionTime != nil {
+			m.LastCompleted = types.StringValue(rotation.LastCompletionTime.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+func mapClusterErrors(cl *ske.Cluster, m *DataSourceModel) error {
+	clusterErrors := []attr.Value{}
+	if cl.Status != nil && cl.Status.Errors != nil {
+		for i, clusterErrorResp := range *cl.Status.Errors {
+			clusterError := map[string]attr.Value{
+				"code":    types.StringPointerValue(clusterErrorResp.Code),
+				"message": types.StringPointerValue(clusterErrorResp.Message),
+			}
+			clusterErrorTF, diags := types.ObjectValue(clusterErrorTypes, clusterError)
+			if diags.HasError() {
+				return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+			}
+			clusterErrors = append(clusterErrors, clusterErrorTF)
+		}
+	}
+
+	clusterErrorsTF, diags := types.ListValue(types.ObjectType{AttrTypes: clusterErrorTypes}, clusterErrors)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	m.Errors = clusterErrorsTF
+	return nil
+}
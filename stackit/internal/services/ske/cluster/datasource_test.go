@@ -0,0 +1,159 @@
+package ske
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description           string
+		input                 *ske.Cluster
+		expectedRotationPhase types.String
+		expectedLastCompleted types.String
+		expectedStatus        types.String
+		expectedHibernated    types.Bool
+		isValid               bool
+	}{
+		{
+			"no_credentials_rotation",
+			&ske.Cluster{
+				Name: utils.Ptr("name"),
+			},
+			types.StringNull(),
+			types.StringNull(),
+			types.StringNull(),
+			types.BoolNull(),
+			true,
+		},
+		{
+			"credentials_rotation",
+			&ske.Cluster{
+				Name: utils.Ptr("name"),
+				Status: &ske.ClusterStatus{
+					Aggregated: ske.CLUSTERSTATUSSTATE_RECONCILING.Ptr(),
+					Hibernated: utils.Ptr(false),
+					CredentialsRotation: &ske.CredentialsRotationState{
+						Phase:              ske.CREDENTIALSROTATIONSTATEPHASE_COMPLETED.Ptr(),
+						LastCompletionTime: utils.Ptr(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+					},
+				},
+			},
+			types.StringValue("COMPLETED"),
+			types.StringValue("2025-01-02T03:04:05Z"),
+			types.StringValue("STATE_RECONCILING"),
+			types.BoolValue(false),
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			types.StringNull(),
+			types.StringNull(),
+			types.StringNull(),
+			types.BoolNull(),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &DataSourceModel{
+				Model: Model{
+					ProjectId: types.StringValue("pid"),
+				},
+			}
+			err := mapDataSourceFields(context.Background(), tt.input, state, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if state.Id.ValueString() != "pid,region,name" {
+					t.Fatalf("Unexpected id: %s", state.Id.ValueString())
+				}
+				if !state.RotationPhase.Equal(tt.expectedRotationPhase) {
+					t.Fatalf("Expected rotation phase %s, got %s", tt.expectedRotationPhase, state.RotationPhase)
+				}
+				if !state.LastCompleted.Equal(tt.expectedLastCompleted) {
+					t.Fatalf("Expected last completed %s, got %s", tt.expectedLastCompleted, state.LastCompleted)
+				}
+				if !state.Status.Equal(tt.expectedStatus) {
+					t.Fatalf("Expected status %s, got %s", tt.expectedStatus, state.Status)
+				}
+				if !state.Hibernated.Equal(tt.expectedHibernated) {
+					t.Fatalf("Expected hibernated %s, got %s", tt.expectedHibernated, state.Hibernated)
+				}
+			}
+		})
+	}
+}
+
+func TestMapClusterErrors(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ske.Cluster
+		expected    types.List
+		isValid     bool
+	}{
+		{
+			"no_status",
+			&ske.Cluster{},
+			types.ListValueMust(types.ObjectType{AttrTypes: clusterErrorTypes}, []attr.Value{}),
+			true,
+		},
+		{
+			"errors",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					Errors: &[]ske.ClusterError{
+						{
+							Code:    utils.Ptr("SKE_QUOTA_EXCEEDED"),
+							Message: utils.Ptr("quota exceeded"),
+						},
+						{
+							Code: utils.Ptr("SKE_INFRA_ERROR"),
+						},
+					},
+				},
+			},
+			types.ListValueMust(types.ObjectType{AttrTypes: clusterErrorTypes}, []attr.Value{
+				types.ObjectValueMust(clusterErrorTypes, map[string]attr.Value{
+					"code":    types.StringValue("SKE_QUOTA_EXCEEDED"),
+					"message": types.StringValue("quota exceeded"),
+				}),
+				types.ObjectValueMust(clusterErrorTypes, map[string]attr.Value{
+					"code":    types.StringValue("SKE_INFRA_ERROR"),
+					"message": types.StringNull(),
+				}),
+			}),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &DataSourceModel{}
+			err := mapClusterErrors(tt.input, state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state.Errors, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,744 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	skeWait "github.com/stackitcloud/stackit-sdk-go/services/ske/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &nodePoolResource{}
+	_ resource.ResourceWithConfigure   = &nodePoolResource{}
+	_ resource.ResourceWithImportState = &nodePoolResource{}
+	_ resource.ResourceWithModifyPlan  = &nodePoolResource{}
+
+	// clusterLocks holds a mutex per cluster. Node pools are part of the cluster spec, so every change
+	// is a read-modify-write of the whole cluster and must not run in parallel for the same cluster.
+	clusterLocks sync.Map
+)
+
+type NodePoolModel struct {
+	Id                    types.String `tfsdk:"id"` // needed by TF
+	ProjectId             types.String `tfsdk:"project_id"`
+	Region                types.String `tfsdk:"region"`
+	ClusterName           types.String `tfsdk:"cluster_name"`
+	Name                  types.String `tfsdk:"name"`
+	MachineType           types.String `tfsdk:"machine_type"`
+	OSName                types.String `tfsdk:"os_name"`
+	OSVersionMin          types.String `tfsdk:"os_version_min"`
+	OSVersionUsed         types.String `tfsdk:"os_version_used"`
+	Minimum               types.Int64  `tfsdk:"minimum"`
+	Maximum               types.Int64  `tfsdk:"maximum"`
+	MaxSurge              types.Int64  `tfsdk:"max_surge"`
+	MaxUnavailable        types.Int64  `tfsdk:"max_unavailable"`
+	VolumeType            types.String `tfsdk:"volume_type"`
+	VolumeSize            types.Int64  `tfsdk:"volume_size"`
+	Labels                types.Map    `tfsdk:"labels"`
+	Taints                types.List   `tfsdk:"taints"`
+	CRI                   types.String `tfsdk:"cri"`
+	AvailabilityZones     types.List   `tfsdk:"availability_zones"`
+	AllowSystemComponents types.Bool   `tfsdk:"allow_system_components"`
+}
+
+// NewNodePoolResource is a helper function to simplify the provider implementation.
+func NewNodePoolResource() resource.Resource {
+	return &nodePoolResource{}
+}
+
+// nodePoolResource is the resource implementation.
+type nodePoolResource struct {
+	skeClient    *ske.APIClient
+	providerData core.ProviderData
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *nodePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel NodePoolModel
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel NodePoolModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// warn if the planned changes replace the nodes of the pool
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var stateModel NodePoolModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateNodePool := toNodePool(&stateModel)
+	planNodePool := toNodePool(&planModel)
+	addNodeRollWarning(&resp.Diagnostics, &stateNodePool, &planNodePool)
+}
+
+// Metadata returns the resource type name.
+func (r *nodePoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_node_pool"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *nodePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	skeClient := skeUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.skeClient = skeClient
+	tflog.Info(ctx, "SKE node pool client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *nodePoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "SKE node pool resource schema. Manages a single node pool of an existing SKE cluster. Must have a `region` specified in the provider configuration. Changing `machine_type`, `os_name`, `os_version_min`, `volume_type`, `volume_size` or `cri` replaces the nodes of the pool in a rolling update, which is reported as a warning in the plan.",
+		"ignore_changes_note": "The node pools are part of the cluster specification, so every change updates the whole cluster. " +
+			"If the cluster is managed by a `stackit_ske_cluster` resource, add `lifecycle { ignore_changes = [node_pools] }` to it, otherwise it will try to remove the node pools managed by this resource.",
+		"max_surge":           "Maximum number of additional VMs that are created during an update.",
+		"max_unavailable":     "Maximum number of VMs that that can be unavailable during an update.",
+		"nodepool_validators": "If set (larger than 0), then it must be at least the amount of zones configured for the nodepool. The `max_surge` and `max_unavailable` fields cannot both be unset at the same time.",
+		"region":              "The resource region. If not defined, the provider region is used.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("%s\n%s", descriptions["main"], descriptions["ignore_changes_note"]),
+		// Callout block: https://developer.hashicorp.com/terraform/registry/providers/docs#callouts
+		MarkdownDescription: fmt.Sprintf("%s\n\n~> %s", descriptions["main"], descriptions["ignore_changes_note"]),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`cluster_name`,`name`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the cluster is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: descriptions["region"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				Description: "The name of the cluster the node pool belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Specifies the name of the node pool.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"machine_type": schema.StringAttribute{
+				Description: "The machine type.",
+				Required:    true,
+			},
+			"availability_zones": schema.ListAttribute{
+				Description: "Specify a list of availability zones. E.g. `eu01-m`",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"allow_system_components": schema.BoolAttribute{
+				Description: "Allow system components to run on this node pool.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"minimum": schema.Int64Attribute{
+				Description: "Minimum number of nodes in the pool.",
+				Required:    true,
+			},
+			"maximum": schema.Int64Attribute{
+				Description: "Maximum number of nodes in the pool.",
+				Required:    true,
+			},
+			"max_surge": schema.Int64Attribute{
+				Description: fmt.Sprintf("%s %s", descriptions["max_surge"], descriptions["nodepool_validators"]),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_unavailable": schema.Int64Attribute{
+				Description: fmt.Sprintf("%s %s", descriptions["max_unavailable"], descriptions["nodepool_validators"]),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"os_name": schema.StringAttribute{
+				Description: "The name of the OS image. Defaults to `flatcar`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(DefaultOSName),
+			},
+			"os_version_min": schema.StringAttribute{
+				Description: "The minimum OS image version. This field will be used to set the minimum OS image version on creation/update of the node pool. If unset, the latest supported OS image version will be used. " + SKEUpdateDoc + " To get the current OS image version being used for the node pool, use the read-only `os_version_used` field.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.VersionNumber(),
+				},
+			},
+			"os_version_used": schema.StringAttribute{
+				Description: "Full OS image version used. For example, if 3815.2 was set in `os_version_min`, this value may result to 3815.2.2. " + SKEUpdateDoc,
+				Computed:    true,
+			},
+			"volume_type": schema.StringAttribute{
+				Description: "Specifies the volume type. Defaults to `storage_premium_perf1`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(DefaultVolumeType),
+			},
+			"volume_size": schema.Int64Attribute{
+				Description: "The volume size in GB. Defaults to `20`",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(DefaultVolumeSizeGB),
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels to add to each node.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"taints": schema.ListNestedAttribute{
+				Description: "Specifies a taint list as defined below.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"effect": schema.StringAttribute{
+							Description: "The taint effect. E.g `PreferNoSchedule`.",
+							Required:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "Taint key to be applied to a node.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "Taint value corresponding to the taint key.",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"cri": schema.StringAttribute{
+				Description: "Specifies the container runtime. Defaults to `containerd`",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(DefaultCRI),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *nodePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model NodePoolModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	unlock := lockCluster(projectId, region, clusterName)
+	defer unlock()
+
+	cl, err := r.skeClient.GetCluster(ctx, projectId, region, clusterName).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating node pool", fmt.Sprintf("Calling API to get cluster: %v", err))
+		return
+	}
+	if findNodePool(cl, name) != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating node pool", fmt.Sprintf("Node pool %q already exists in cluster %q. Import it instead.", name, clusterName))
+		return
+	}
+
+	r.createOrUpdateNodePool(ctx, &resp.Diagnostics, &model, cl)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE node pool created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *nodePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var state NodePoolModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := state.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(state.Region)
+	clusterName := state.ClusterName.ValueString()
+	name := state.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	cl, err := r.skeClient.GetCluster(ctx, projectId, region, clusterName).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading node pool", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	nodePoolResp := findNodePool(cl, name)
+	if nodePoolResp == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapNodePoolFields(ctx, nodePoolResp, &state, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading node pool", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE node pool read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *nodePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model NodePoolModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	unlock := lockCluster(projectId, region, clusterName)
+	defer unlock()
+
+	cl, err := r.skeClient.GetCluster(ctx, projectId, region, clusterName).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating node pool", fmt.Sprintf("Calling API to get cluster: %v", err))
+		return
+	}
+
+	r.createOrUpdateNodePool(ctx, &resp.Diagnostics, &model, cl)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE node pool updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *nodePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model NodePoolModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "name", name)
+
+	unlock := lockCluster(projectId, region, clusterName)
+	defer unlock()
+
+	cl, err := r.skeClient.GetCluster(ctx, projectId, region, clusterName).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "SKE cluster not found, node pool already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting node pool", fmt.Sprintf("Calling API to get cluster: %v", err))
+		return
+	}
+	if findNodePool(cl, name) == nil {
+		tflog.Info(ctx, "SKE node pool not found in cluster, already deleted")
+		return
+	}
+
+	nodePools := removeNodePool(cl.GetNodepools(), name)
+	if len(nodePools) == 0 {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting node pool", fmt.Sprintf("Node pool %q is the last node pool of cluster %q. A cluster needs at least one node pool, delete the cluster instead.", name, clusterName))
+		return
+	}
+	if err := verifySystemComponentsInNodePools(nodePools); err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting node pool", fmt.Sprintf("Removing node pool %q from cluster %q: %v", name, clusterName, err))
+		return
+	}
+
+	_, err = r.updateClusterNodePools(ctx, cl, projectId, region, clusterName, nodePools)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting node pool", err.Error())
+		return
+	}
+	tflog.Info(ctx, "SKE node pool deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,region,cluster_name,name
+func (r *nodePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing node pool",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[cluster_name],[name]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[3])...)
+	tflog.Info(ctx, "SKE node pool state imported")
+}
+
+// createOrUpdateNodePool sets the node pool of the model in the given cluster spec, sends the
+// updated spec to the API and maps the resulting node pool back to the model.
+func (r *nodePoolResource) createOrUpdateNodePool(ctx context.Context, diags *diag.Diagnostics, model *NodePoolModel, cl *ske.Cluster) {
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	name := model.Name.ValueString()
+
+	providerOptions, err := r.skeClient.ListProviderOptions(ctx, region).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, diags, "Error creating/updating node pool", fmt.Sprintf("Loading available machine image versions: %v", err))
+		return
+	}
+
+	var currentMachineImage *ske.Image
+	if current := findNodePool(cl, name); current != nil && current.Machine != nil {
+		currentMachineImage = current.Machine.Image
+	}
+
+	nodePoolModel := toNodePool(model)
+	nodePool, deprecatedVersion, err := toNodepoolPayload(ctx, &nodePoolModel, providerOptions.GetMachineImages(), currentMachineImage)
+	if err != nil {
+		core.LogAndAddError(ctx, diags, "Error creating/updating node pool", fmt.Sprintf("Creating node pool API payload: %v", err))
+		return
+	}
+	if deprecatedVersion != nil {
+		diags.AddWarning("Deprecated node pool OS version used", fmt.Sprintf("Version %s of the machine image is deprecated, please update it", *deprecatedVersion))
+	}
+
+	nodePools := setNodePool(cl.GetNodepools(), nodePool)
+	if err := verifySystemComponentsInNodePools(nodePools); err != nil {
+		core.LogAndAddError(ctx, diags, "Error creating/updating node pool", err.Error())
+		return
+	}
+
+	waitResp, err := r.updateClusterNodePools(ctx, cl, projectId, region, clusterName, nodePools)
+	if err != nil {
+		core.LogAndAddError(ctx, diags, "Error creating/updating node pool", err.Error())
+		return
+	}
+
+	nodePoolResp := findNodePool(waitResp, name)
+	if nodePoolResp == nil {
+		core.LogAndAddError(ctx, diags, "Error creating/updating node pool", fmt.Sprintf("Node pool %q not found in cluster %q after update", name, clusterName))
+		return
+	}
+
+	err = mapNodePoolFields(ctx, nodePoolResp, model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, diags, "Error creating/updating node pool", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+}
+
+// updateClusterNodePools sends the spec of the given cluster with the given node pools to the API and waits for the update to finish.
+func (r *nodePoolResource) updateClusterNodePools(ctx context.Context, cl *ske.Cluster, projectId, region, clusterName string, nodePools []ske.Nodepool) (*ske.Cluster, error) {
+	payload, err := toClusterPayload(cl, nodePools)
+	if err != nil {
+		return nil, fmt.Errorf("creating cluster API payload: %w", err)
+	}
+
+	_, err = r.skeClient.CreateOrUpdateCluster(ctx, projectId, region, clusterName).CreateOrUpdateClusterPayload(*payload).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("calling API: %w", err)
+	}
+
+	waitResp, err := skeWait.CreateOrUpdateClusterWaitHandler(ctx, r.skeClient, projectId, region, clusterName).WaitWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cluster update waiting: %w", err)
+	}
+	return waitResp, nil
+}
+
+// lockCluster locks the mutex of the given cluster and returns the function to unlock it.
+func lockCluster(projectId, region, clusterName string) func() {
+	key := strings.Join([]string{projectId, region, clusterName}, core.Separator)
+	lock, _ := clusterLocks.LoadOrStore(key, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+// findNodePool returns the node pool with the given name of the cluster, or nil if it doesn't exist.
+func findNodePool(cl *ske.Cluster, name string) *ske.Nodepool {
+	if cl == nil || cl.Nodepools == nil {
+		return nil
+	}
+	for i := range *cl.Nodepools {
+		if (*cl.Nodepools)[i].GetName() == name {
+			return &(*cl.Nodepools)[i]
+		}
+	}
+	return nil
+}
+
+// setNodePool replaces the node pool with the same name in the list, or appends it if there is none.
+// The order of the other node pools is kept.
+func setNodePool(nodePools []ske.Nodepool, nodePool *ske.Nodepool) []ske.Nodepool {
+	result := []ske.Nodepool{}
+	replaced := false
+	for i := range nodePools {
+		if nodePools[i].GetName() == nodePool.GetName() {
+			result = append(result, *nodePool)
+			replaced = true
+			continue
+		}
+		result = append(result, nodePools[i])
+	}
+	if !replaced {
+		result = append(result, *nodePool)
+	}
+	return result
+}
+
+// removeNodePool returns the list without the node pool with the given name.
+func removeNodePool(nodePools []ske.Nodepool, name string) []ske.Nodepool {
+	result := []ske.Nodepool{}
+	for i := range nodePools {
+		if nodePools[i].GetName() == name {
+			continue
+		}
+		result = append(result, nodePools[i])
+	}
+	return result
+}
+
+// toClusterPayload builds the update payload from the current cluster spec, replacing only its node pools.
+func toClusterPayload(cl *ske.Cluster, nodePools []ske.Nodepool) (*ske.CreateOrUpdateClusterPayload, error) {
+	if cl == nil {
+		return nil, fmt.Errorf("cluster input is nil")
+	}
+	if cl.Kubernetes == nil {
+		return nil, fmt.Errorf("cluster has no kubernetes configuration")
+	}
+
+	return &ske.CreateOrUpdateClusterPayload{
+		Extensions:  cl.Extensions,
+		Hibernation: cl.Hibernation,
+		Kubernetes:  cl.Kubernetes,
+		Maintenance: cl.Maintenance,
+		Network:     cl.Network,
+		Nodepools:   &nodePools,
+	}, nil
+}
+
+// toNodePool converts the resource model to the node pool struct used by the cluster resource.
+func toNodePool(m *NodePoolModel) nodePool {
+	return nodePool{
+		Name:                  m.Name,
+		MachineType:           m.MachineType,
+		OSName:                m.OSName,
+		OSVersionMin:          m.OSVersionMin,
+		OSVersion:             types.StringNull(),
+		OSVersionUsed:         m.OSVersionUsed,
+		Minimum:               m.Minimum,
+		Maximum:               m.Maximum,
+		MaxSurge:              m.MaxSurge,
+		MaxUnavailable:        m.MaxUnavailable,
+		VolumeType:            m.VolumeType,
+		VolumeSize:            m.VolumeSize,
+		Labels:                m.Labels,
+		Taints:                m.Taints,
+		CRI:                   m.CRI,
+		AvailabilityZones:     m.AvailabilityZones,
+		AllowSystemComponents: m.AllowSystemComponents,
+	}
+}
+
+func mapNodePoolFields(ctx context.Context, nodePoolResp *ske.Nodepool, m *NodePoolModel, region string) error {
+	if nodePoolResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if m == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var name string
+	if m.Name.ValueString() != "" {
+		name = m.Name.ValueString()
+	} else if nodePoolResp.Name != nil {
+		name = *nodePoolResp.Name
+	} else {
+		return fmt.Errorf("name not present")
+	}
+
+	taintsInModel := !m.Taints.IsNull() && !m.Taints.IsUnknown()
+	nodePoolTF, err := mapNodePool(ctx, nodePoolResp, m.OSVersionMin, types.StringNull(), taintsInModel)
+	if err != nil {
+		return err
+	}
+	var np nodePool
+	diags := nodePoolTF.As(ctx, &np, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+
+	m.Id = utils.BuildInternalTerraformId(m.ProjectId.ValueString(), region, m.ClusterName.ValueString(), name)
+	m.Region = types.StringValue(region)
+	m.Name = types.StringValue(name)
+	m.MachineType = np.MachineType
+	m.OSName = np.OSName
+	m.OSVersionMin = np.OSVersionMin
+	m.OSVersionUsed = np.OSVersionUsed
+	m.Minimum = np.Minimum
+	m.Maximum = np.Maximum
+	m.MaxSurge = np.MaxSurge
+	m.MaxUnavailable = np.MaxUnavailable
+	m.VolumeType = np.VolumeType
+	m.VolumeSize = np.VolumeSize
+	m.Labels = np.Labels
+	m.Taints = np.Taints
+	m.CRI = np.CRI
+	m.AvailabilityZones = np.AvailabilityZones
+	m.AllowSystemComponents = np.AllowSystemComponents
+	return nil
+}
@@ -0,0 +1,411 @@
+package ske
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+func TestMapNodePoolFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       NodePoolModel
+		input       *ske.Nodepool
+		expected    NodePoolModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			NodePoolModel{
+				ProjectId:   types.StringValue("pid"),
+				ClusterName: types.StringValue("cluster"),
+				Name:        types.StringValue("np"),
+			},
+			&ske.Nodepool{
+				Name: utils.Ptr("np"),
+			},
+			NodePoolModel{
+				Id:                    types.StringValue("pid,region,cluster,np"),
+				ProjectId:             types.StringValue("pid"),
+				Region:                types.StringValue(testRegion),
+				ClusterName:           types.StringValue("cluster"),
+				Name:                  types.StringValue("np"),
+				MachineType:           types.StringNull(),
+				OSName:                types.StringNull(),
+				OSVersionMin:          types.StringNull(),
+				OSVersionUsed:         types.StringNull(),
+				Minimum:               types.Int64Null(),
+				Maximum:               types.Int64Null(),
+				MaxSurge:              types.Int64Null(),
+				MaxUnavailable:        types.Int64Null(),
+				VolumeType:            types.StringNull(),
+				VolumeSize:            types.Int64Null(),
+				Labels:                types.MapNull(types.StringType),
+				Taints:                types.ListNull(types.ObjectType{AttrTypes: taintTypes}),
+				CRI:                   types.StringNull(),
+				AvailabilityZones:     types.ListNull(types.StringType),
+				AllowSystemComponents: types.BoolNull(),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			NodePoolModel{
+				ProjectId:    types.StringValue("pid"),
+				ClusterName:  types.StringValue("cluster"),
+				Name:         types.StringValue("np"),
+				OSVersionMin: types.StringValue("1.2"),
+			},
+			&ske.Nodepool{
+				AllowSystemComponents: utils.Ptr(false),
+				AvailabilityZones:     &[]string{"z1", "z2"},
+				Cri: &ske.CRI{
+					Name: ske.CRINAME_CONTAINERD.Ptr(),
+				},
+				Labels: &map[string]string{"k": "v"},
+				Machine: &ske.Machine{
+					Image: &ske.Image{
+						Name:    utils.Ptr("os"),
+						Version: utils.Ptr("1.2.3"),
+					},
+					Type: utils.Ptr("B"),
+				},
+				MaxSurge:       utils.Ptr(int64(2)),
+				MaxUnavailable: utils.Ptr(int64(1)),
+				Maximum:        utils.Ptr(int64(5)),
+				Minimum:        utils.Ptr(int64(1)),
+				Name:           utils.Ptr("np"),
+				Taints: &[]ske.Taint{
+					{
+						Effect: ske.TAINTEFFECT_NO_SCHEDULE.Ptr(),
+						Key:    utils.Ptr("key"),
+						Value:  utils.Ptr("value"),
+					},
+				},
+				Volume: &ske.Volume{
+					Size: utils.Ptr(int64(30)),
+					Type: utils.Ptr("type"),
+				},
+			},
+			NodePoolModel{
+				Id:             types.StringValue("pid,region,cluster,np"),
+				ProjectId:      types.StringValue("pid"),
+				Region:         types.StringValue(testRegion),
+				ClusterName:    types.StringValue("cluster"),
+				Name:           types.StringValue("np"),
+				MachineType:    types.StringValue("B"),
+				OSName:         types.StringValue("os"),
+				OSVersionMin:   types.StringValue("1.2"),
+				OSVersionUsed:  types.StringValue("1.2.3"),
+				Minimum:        types.Int64Value(1),
+				Maximum:        types.Int64Value(5),
+				MaxSurge:       types.Int64Value(2),
+				MaxUnavailable: types.Int64Value(1),
+				VolumeType:     types.StringValue("type"),
+				VolumeSize:     types.Int64Value(30),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"k": types.StringValue("v"),
+				}),
+				Taints: types.ListValueMust(types.ObjectType{AttrTypes: taintTypes}, []attr.Value{
+					types.ObjectValueMust(taintTypes, map[string]attr.Value{
+						"effect": types.StringValue(string(ske.TAINTEFFECT_NO_SCHEDULE)),
+						"key":    types.StringValue("key"),
+						"value":  types.StringValue("value"),
+					}),
+				}),
+				CRI: types.StringValue(string(ske.CRINAME_CONTAINERD)),
+				AvailabilityZones: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("z1"),
+					types.StringValue("z2"),
+				}),
+				AllowSystemComponents: types.BoolValue(false),
+			},
+			true,
+		},
+		{
+			"empty_taints_in_state",
+			NodePoolModel{
+				ProjectId:   types.StringValue("pid"),
+				ClusterName: types.StringValue("cluster"),
+				Name:        types.StringValue("np"),
+				Taints:      types.ListValueMust(types.ObjectType{AttrTypes: taintTypes}, []attr.Value{}),
+			},
+			&ske.Nodepool{
+				Name: utils.Ptr("np"),
+			},
+			NodePoolModel{
+				Id:                    types.StringValue("pid,region,cluster,np"),
+				ProjectId:             types.StringValue("pid"),
+				Region:                types.StringValue(testRegion),
+				ClusterName:           types.StringValue("cluster"),
+				Name:                  types.StringValue("np"),
+				MachineType:           types.StringNull(),
+				OSName:                types.StringNull(),
+				OSVersionMin:          types.StringNull(),
+				OSVersionUsed:         types.StringNull(),
+				Minimum:               types.Int64Null(),
+				Maximum:               types.Int64Null(),
+				MaxSurge:              types.Int64Null(),
+				MaxUnavailable:        types.Int64Null(),
+				VolumeType:            types.StringNull(),
+				VolumeSize:            types.Int64Null(),
+				Labels:                types.MapNull(types.StringType),
+				Taints:                types.ListValueMust(types.ObjectType{AttrTypes: taintTypes}, []attr.Value{}),
+				CRI:                   types.StringNull(),
+				AvailabilityZones:     types.ListNull(types.StringType),
+				AllowSystemComponents: types.BoolNull(),
+			},
+			true,
+		},
+		{
+			"nil_response",
+			NodePoolModel{
+				ProjectId:   types.StringValue("pid"),
+				ClusterName: types.StringValue("cluster"),
+				Name:        types.StringValue("np"),
+			},
+			nil,
+			NodePoolModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := tt.state
+			err := mapNodePoolFields(context.Background(), tt.input, &state, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSetNodePool(t *testing.T) {
+	tests := []struct {
+		description string
+		nodePools   []ske.Nodepool
+		input       *ske.Nodepool
+		expected    []ske.Nodepool
+	}{
+		{
+			"append",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("np1")},
+			},
+			&ske.Nodepool{Name: utils.Ptr("np2")},
+			[]ske.Nodepool{
+				{Name: utils.Ptr("np1")},
+				{Name: utils.Ptr("np2")},
+			},
+		},
+		{
+			"replace_keeps_order",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("np1"), Minimum: utils.Ptr(int64(1))},
+				{Name: utils.Ptr("np2"), Minimum: utils.Ptr(int64(1))},
+				{Name: utils.Ptr("np3"), Minimum: utils.Ptr(int64(1))},
+			},
+			&ske.Nodepool{Name: utils.Ptr("np2"), Minimum: utils.Ptr(int64(2))},
+			[]ske.Nodepool{
+				{Name: utils.Ptr("np1"), Minimum: utils.Ptr(int64(1))},
+				{Name: utils.Ptr("np2"), Minimum: utils.Ptr(int64(2))},
+				{Name: utils.Ptr("np3"), Minimum: utils.Ptr(int64(1))},
+			},
+		},
+		{
+			"empty_list",
+			nil,
+			&ske.Nodepool{Name: utils.Ptr("np1")},
+			[]ske.Nodepool{
+				{Name: utils.Ptr("np1")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := setNodePool(tt.nodePools, tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveNodePool(t *testing.T) {
+	tests := []struct {
+		description string
+		nodePools   []ske.Nodepool
+		name        string
+		expected    []ske.Nodepool
+	}{
+		{
+			"remove",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("np1")},
+				{Name: utils.Ptr("np2")},
+				{Name: utils.Ptr("np3")},
+			},
+			"np2",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("np1")},
+				{Name: utils.Ptr("np3")},
+			},
+		},
+		{
+			"not_found",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("np1")},
+			},
+			"np2",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("np1")},
+			},
+		},
+		{
+			"remove_last",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("np1")},
+			},
+			"np1",
+			[]ske.Nodepool{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := removeNodePool(tt.nodePools, tt.name)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToClusterPayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ske.Cluster
+		nodePools   []ske.Nodepool
+		expected    *ske.CreateOrUpdateClusterPayload
+		isValid     bool
+	}{
+		{
+			"keeps_cluster_spec",
+			&ske.Cluster{
+				Name: utils.Ptr("cluster"),
+				Extensions: &ske.Extension{
+					Acl: &ske.ACL{
+						AllowedCidrs: &[]string{"cidr1"},
+						Enabled:      utils.Ptr(true),
+					},
+				},
+				Hibernation: &ske.Hibernation{
+					Schedules: &[]ske.HibernationSchedule{
+						{
+							End:   utils.Ptr("2"),
+							Start: utils.Ptr("1"),
+						},
+					},
+				},
+				Kubernetes: &ske.Kubernetes{
+					Version: utils.Ptr("1.2.3"),
+				},
+				Maintenance: &ske.Maintenance{
+					AutoUpdate: &ske.MaintenanceAutoUpdate{
+						KubernetesVersion: utils.Ptr(true),
+					},
+				},
+				Network: &ske.Network{
+					Id: utils.Ptr("nid"),
+				},
+				Nodepools: &[]ske.Nodepool{
+					{Name: utils.Ptr("np1")},
+				},
+			},
+			[]ske.Nodepool{
+				{Name: utils.Ptr("np1")},
+				{Name: utils.Ptr("np2")},
+			},
+			&ske.CreateOrUpdateClusterPayload{
+				Extensions: &ske.Extension{
+					Acl: &ske.ACL{
+						AllowedCidrs: &[]string{"cidr1"},
+						Enabled:      utils.Ptr(true),
+					},
+				},
+				Hibernation: &ske.Hibernation{
+					Schedules: &[]ske.HibernationSchedule{
+						{
+							End:   utils.Ptr("2"),
+							Start: utils.Ptr("1"),
+						},
+					},
+				},
+				Kubernetes: &ske.Kubernetes{
+					Version: utils.Ptr("1.2.3"),
+				},
+				Maintenance: &ske.Maintenance{
+					AutoUpdate: &ske.MaintenanceAutoUpdate{
+						KubernetesVersion: utils.Ptr(true),
+					},
+				},
+				Network: &ske.Network{
+					Id: utils.Ptr("nid"),
+				},
+				Nodepools: &[]ske.Nodepool{
+					{Name: utils.Ptr("np1")},
+					{Name: utils.Ptr("np2")},
+				},
+			},
+			true,
+		},
+		{
+			"no_kubernetes",
+			&ske.Cluster{
+				Name: utils.Ptr("cluster"),
+			},
+			[]ske.Nodepool{},
+			nil,
+			false,
+		},
+		{
+			"nil_cluster",
+			nil,
+			[]ske.Nodepool{},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toClusterPayload(tt.input, tt.nodePools)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
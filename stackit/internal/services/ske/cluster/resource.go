@@ -53,6 +53,9 @@ const (
 	VersionStatePreview          = "preview"
 	VersionStateDeprecated       = "deprecated"
 
+	DesiredStateHibernated = "hibernated"
+	DesiredStateRunning    = "running"
+
 	SKEUpdateDoc = "SKE automatically updates the cluster Kubernetes version if you have set `maintenance.enable_kubernetes_version_updates` to true or if there is a mandatory update, as described in [Updates for Kubernetes versions and Operating System versions in SKE](https://docs.stackit.cloud/stackit/en/version-updates-in-ske-10125631.html)."
 )
 
@@ -62,6 +65,8 @@ var (
 	_ resource.ResourceWithConfigure   = &clusterResource{}
 	_ resource.ResourceWithImportState = &clusterResource{}
 	_ resource.ResourceWithModifyPlan  = &clusterResource{}
+
+	desiredStateOptions = []string{DesiredStateHibernated, DesiredStateRunning}
 )
 
 type skeClient interface {
@@ -84,6 +89,12 @@ type Model struct {
 	Region                types.String `tfsdk:"region"`
 }
 
+// ResourceModel extends the cluster model with fields only available in the resource.
+type ResourceModel struct {
+	Model
+	DesiredState types.String `tfsdk:"desired_state"`
+}
+
 // Struct corresponding to Model.NodePools[i]
 type nodePool struct {
 	Name                  types.String `tfsdk:"name"`
@@ -259,7 +270,7 @@ type clusterResource struct {
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
-	var configModel Model
+	var configModel ResourceModel
 	// skip initial empty configuration to avoid follow-up errors
 	if req.Config.Raw.IsNull() {
 		return
@@ -269,7 +280,7 @@ func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
-	var planModel Model
+	var planModel ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -284,6 +295,75 @@ func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// warn about node pools whose nodes are replaced by the planned changes
+	if req.State.Raw.IsNull() || planModel.NodePools.IsUnknown() {
+		return
+	}
+	var stateModel ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateNodePools := []nodePool{}
+	if !stateModel.NodePools.IsNull() && !stateModel.NodePools.IsUnknown() {
+		resp.Diagnostics.Append(stateModel.NodePools.ElementsAs(ctx, &stateNodePools, false)...)
+	}
+	planNodePools := []nodePool{}
+	if !planModel.NodePools.IsNull() {
+		resp.Diagnostics.Append(planModel.NodePools.ElementsAs(ctx, &planNodePools, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i := range planNodePools {
+		for j := range stateNodePools {
+			if planNodePools[i].Name.Equal(stateNodePools[j].Name) {
+				addNodeRollWarning(&resp.Diagnostics, &stateNodePools[j], &planNodePools[i])
+			}
+		}
+	}
+}
+
+// nodeRollChanges returns the attributes changed between state and plan which cause the nodes of the pool to be replaced.
+// Changes to the other attributes, e.g. labels, taints or the scaling settings, are applied to the existing nodes.
+func nodeRollChanges(stateNodePool, planNodePool *nodePool) []string {
+	attributes := []struct {
+		name  string
+		state attr.Value
+		plan  attr.Value
+	}{
+		{"machine_type", stateNodePool.MachineType, planNodePool.MachineType},
+		{"os_name", stateNodePool.OSName, planNodePool.OSName},
+		{"os_version_min", stateNodePool.OSVersionMin, planNodePool.OSVersionMin},
+		{"os_version", stateNodePool.OSVersion, planNodePool.OSVersion},
+		{"volume_type", stateNodePool.VolumeType, planNodePool.VolumeType},
+		{"volume_size", stateNodePool.VolumeSize, planNodePool.VolumeSize},
+		{"cri", stateNodePool.CRI, planNodePool.CRI},
+	}
+
+	changes := []string{}
+	for _, a := range attributes {
+		// unknown values are set by the API, so they can't be compared at plan time
+		if a.plan.IsUnknown() || a.plan.IsNull() {
+			continue
+		}
+		if !a.plan.Equal(a.state) {
+			changes = append(changes, a.name)
+		}
+	}
+	return changes
+}
+
+func addNodeRollWarning(diags *diag.Diagnostics, stateNodePool, planNodePool *nodePool) {
+	changes := nodeRollChanges(stateNodePool, planNodePool)
+	if len(changes) == 0 {
+		return
+	}
+	diags.AddWarning(
+		"Node pool will be rolled",
+		fmt.Sprintf("Changing %s of node pool %q replaces all of its nodes in a rolling update, honoring max_surge and max_unavailable. Workloads on the nodes are evicted.", strings.Join(changes, ", "), planNodePool.Name.ValueString()),
+	)
 }
 
 // Metadata returns the resource type name.
@@ -322,6 +402,7 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 		"max_unavailable":     "Maximum number of VMs that that can be unavailable during an update.",
 		"nodepool_validators": "If set (larger than 0), then it must be at least the amount of zones configured for the nodepool. The `max_surge` and `max_unavailable` fields cannot both be unset at the same time.",
 		"region":              "The resource region. If not defined, the provider region is used.",
+		"desired_state":       "The desired state of the cluster. Setting it to `hibernated` hibernates the cluster on demand, setting it to `running` wakes it up again. If unset, the state is not changed. If set, a state change outside of Terraform, e.g. by `hibernations` schedules, shows up as a difference in the plan.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -379,7 +460,7 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				ElementType: types.StringType,
 			},
 			"node_pools": schema.ListNestedAttribute{
-				Description: "One or more `node_pool` block as defined below.",
+				Description: "One or more `node_pool` block as defined below. To manage further node pools with `stackit_ske_node_pool` resources, add `node_pools` to the `ignore_changes` of the cluster's `lifecycle` block. The list is then only used when creating the cluster. Changing `machine_type`, `os_name`, `os_version_min`, `os_version`, `volume_type`, `volume_size` or `cri` of a node pool replaces its nodes in a rolling update, which is reported as a warning in the plan. Changes to the other attributes, e.g. `labels` or `taints`, are applied to the existing nodes.",
 				Required:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -668,20 +749,27 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"desired_state": schema.StringAttribute{
+				Description: descriptions["desired_state"] + " " + utils.SupportedValuesDocumentation(desiredStateOptions),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(desiredStateOptions...),
+				},
+			},
 		},
 	}
 }
 
 // The argus extension is deprecated but can still be used until it is removed on 06 January 2026.
 func (r *clusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var resourceModel Model
+	var resourceModel ResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &resourceModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// function is used in order to be able to write easier unit tests
-	validateConfig(ctx, &resp.Diagnostics, &resourceModel)
+	validateConfig(ctx, &resp.Diagnostics, &resourceModel.Model)
 }
 
 func validateConfig(ctx context.Context, respDiags *diag.Diagnostics, model *Model) {
@@ -704,7 +792,7 @@ func validateConfig(ctx context.Context, respDiags *diag.Diagnostics, model *Mod
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -737,7 +825,12 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableKubernetesVersions, availableMachines, nil, nil)
+	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model.Model, availableKubernetesVersions, availableMachines, nil, nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateClusterState(ctx, &resp.Diagnostics, &model)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -829,6 +922,11 @@ func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag
 	projectId := model.ProjectId.ValueString()
 	name := model.Name.ValueString()
 	region := model.Region.ValueString()
+
+	// node pools may also be managed by stackit_ske_node_pool resources, which update the same cluster spec
+	unlock := lockCluster(projectId, region, name)
+	defer unlock()
+
 	kubernetes, hasDeprecatedVersion, err := toKubernetesPayload(model, availableKubernetesVersions, currentKubernetesVersion, diags)
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error creating/updating cluster", fmt.Sprintf("Creating cluster config API payload: %v", err))
@@ -896,6 +994,84 @@ func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag
 	}
 }
 
+// updateClusterState hibernates or wakes up the cluster if its current state differs from the desired state.
+func (r *clusterResource) updateClusterState(ctx context.Context, diags *diag.Diagnostics, model *ResourceModel) {
+	projectId := model.ProjectId.ValueString()
+	name := model.Name.ValueString()
+	region := model.Region.ValueString()
+
+	cl, err := r.skeClient.GetCluster(ctx, projectId, region, name).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, diags, "Error changing cluster state", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	var waitResp *ske.Cluster
+	switch clusterStateTransition(cl, model.DesiredState.ValueString()) {
+	case DesiredStateHibernated:
+		tflog.Debug(ctx, "hibernating cluster")
+		_, err = r.skeClient.TriggerHibernate(ctx, projectId, region, name).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, diags, "Error changing cluster state", fmt.Sprintf("Calling API to hibernate cluster: %v", err))
+			return
+		}
+		waitResp, err = skeWait.TriggerClusterHibernationWaitHandler(ctx, r.skeClient, projectId, region, name).WaitWithContext(ctx)
+		if err != nil {
+			core.LogAndAddError(ctx, diags, "Error changing cluster state", fmt.Sprintf("Cluster hibernation waiting: %v", err))
+			return
+		}
+	case DesiredStateRunning:
+		tflog.Debug(ctx, "waking up cluster")
+		_, err = r.skeClient.TriggerWakeup(ctx, projectId, region, name).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, diags, "Error changing cluster state", fmt.Sprintf("Calling API to wake up cluster: %v", err))
+			return
+		}
+		waitResp, err = skeWait.TriggerClusterWakeupWaitHandler(ctx, r.skeClient, projectId, region, name).WaitWithContext(ctx)
+		if err != nil {
+			core.LogAndAddError(ctx, diags, "Error changing cluster state", fmt.Sprintf("Cluster wakeup waiting: %v", err))
+			return
+		}
+	default:
+		tflog.Debug(ctx, fmt.Sprintf("nothing to do for desired state %q", model.DesiredState.ValueString()))
+		return
+	}
+
+	err = mapFields(ctx, waitResp, &model.Model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, diags, "Error changing cluster state", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+}
+
+// mapDesiredState sets the desired state to the current state of the cluster, if it is managed by Terraform.
+func mapDesiredState(cl *ske.Cluster, model *ResourceModel) {
+	if model.DesiredState.IsNull() || model.DesiredState.IsUnknown() {
+		return
+	}
+	if cl != nil && cl.Status != nil && cl.Status.GetHibernated() {
+		model.DesiredState = types.StringValue(DesiredStateHibernated)
+		return
+	}
+	model.DesiredState = types.StringValue(DesiredStateRunning)
+}
+
+// clusterStateTransition returns the state the cluster has to be moved to, or an empty string if it already is in the desired state.
+func clusterStateTransition(cl *ske.Cluster, desiredState string) string {
+	hibernated := cl != nil && cl.Status != nil && cl.Status.GetHibernated()
+	switch desiredState {
+	case DesiredStateHibernated:
+		if !hibernated {
+			return DesiredStateHibernated
+		}
+	case DesiredStateRunning:
+		if hibernated {
+			return DesiredStateRunning
+		}
+	}
+	return ""
+}
+
 func toNodepoolsPayload(ctx context.Context, m *Model, availableMachineVersions []ske.MachineImage, currentMachineImages map[string]*ske.Image) ([]ske.Nodepool, []string, error) {
 	nodePools := []nodePool{}
 	diags := m.NodePools.ElementsAs(ctx, &nodePools, false)
@@ -913,114 +1089,129 @@ func toNodepoolsPayload(ctx context.Context, m *Model, availableMachineVersions
 			return nil, nil, fmt.Errorf("found nil node pool name for node_pool[%d]", i)
 		}
 
-		// taints
-		taintsModel := []taint{}
-		diags := nodePool.Taints.ElementsAs(ctx, &taintsModel, false)
-		if diags.HasError() {
-			return nil, nil, core.DiagsToError(diags)
+		cnp, deprecatedVersion, err := toNodepoolPayload(ctx, &nodePool, availableMachineVersions, currentMachineImages[*name])
+		if err != nil {
+			return nil, nil, err
 		}
-
-		ts := []ske.Taint{}
-		for _, v := range taintsModel {
-			t := ske.Taint{
-				Effect: ske.TaintGetEffectAttributeType(conversion.StringValueToPointer(v.Effect)),
-				Key:    conversion.StringValueToPointer(v.Key),
-				Value:  conversion.StringValueToPointer(v.Value),
-			}
-			ts = append(ts, t)
+		if deprecatedVersion != nil {
+			deprecatedVersionsUsed = append(deprecatedVersionsUsed, *deprecatedVersion)
 		}
+		cnps = append(cnps, *cnp)
+	}
 
-		// labels
-		var ls *map[string]string
-		if nodePool.Labels.IsNull() {
-			ls = nil
-		} else {
-			lsm := map[string]string{}
-			for k, v := range nodePool.Labels.Elements() {
-				nv, err := conversion.ToString(ctx, v)
-				if err != nil {
-					lsm[k] = ""
-					continue
-				}
-				lsm[k] = nv
-			}
-			ls = &lsm
+	if err := verifySystemComponentsInNodePools(cnps); err != nil {
+		return nil, nil, err
+	}
+
+	return cnps, deprecatedVersionsUsed, nil
+}
+
+// toNodepoolPayload builds the payload of a single node pool. If the selected machine image version is deprecated, it is returned as well.
+func toNodepoolPayload(ctx context.Context, nodePool *nodePool, availableMachineVersions []ske.MachineImage, currentMachineImage *ske.Image) (cnp *ske.Nodepool, deprecatedVersion *string, err error) {
+	name := conversion.StringValueToPointer(nodePool.Name)
+	if name == nil {
+		return nil, nil, fmt.Errorf("found nil node pool name")
+	}
+
+	// taints
+	taintsModel := []taint{}
+	diags := nodePool.Taints.ElementsAs(ctx, &taintsModel, false)
+	if diags.HasError() {
+		return nil, nil, core.DiagsToError(diags)
+	}
+
+	ts := []ske.Taint{}
+	for _, v := range taintsModel {
+		t := ske.Taint{
+			Effect: ske.TaintGetEffectAttributeType(conversion.StringValueToPointer(v.Effect)),
+			Key:    conversion.StringValueToPointer(v.Key),
+			Value:  conversion.StringValueToPointer(v.Value),
 		}
+		ts = append(ts, t)
+	}
 
-		// zones
-		zs := []string{}
-		for _, v := range nodePool.AvailabilityZones.Elements() {
-			if v.IsNull() || v.IsUnknown() {
-				continue
-			}
-			s, err := conversion.ToString(ctx, v)
+	// labels
+	var ls *map[string]string
+	if nodePool.Labels.IsNull() {
+		ls = nil
+	} else {
+		lsm := map[string]string{}
+		for k, v := range nodePool.Labels.Elements() {
+			nv, err := conversion.ToString(ctx, v)
 			if err != nil {
+				lsm[k] = ""
 				continue
 			}
-			zs = append(zs, s)
-		}
-
-		cn := ske.CRI{
-			Name: ske.CRIGetNameAttributeType(conversion.StringValueToPointer(nodePool.CRI)),
+			lsm[k] = nv
 		}
+		ls = &lsm
+	}
 
-		providedVersionMin := conversion.StringValueToPointer(nodePool.OSVersionMin)
-		if !nodePool.OSVersion.IsNull() {
-			if providedVersionMin != nil {
-				return nil, nil, fmt.Errorf("both `os_version` and `os_version_min` are set for for node_pool %q. Please use `os_version_min` only, `os_version` is deprecated", *name)
-			}
-			// os_version field deprecation
-			// this if clause should be removed once os_version field is completely removed
-			// os_version field value is used as minimum os version
-			providedVersionMin = conversion.StringValueToPointer(nodePool.OSVersion)
+	// zones
+	zs := []string{}
+	for _, v := range nodePool.AvailabilityZones.Elements() {
+		if v.IsNull() || v.IsUnknown() {
+			continue
 		}
-
-		machineOSName := conversion.StringValueToPointer(nodePool.OSName)
-		if machineOSName == nil {
-			return nil, nil, fmt.Errorf("found nil machine name for node_pool %q", *name)
+		s, err := conversion.ToString(ctx, v)
+		if err != nil {
+			continue
 		}
+		zs = append(zs, s)
+	}
 
-		currentMachineImage := currentMachineImages[*name]
+	cn := ske.CRI{
+		Name: ske.CRIGetNameAttributeType(conversion.StringValueToPointer(nodePool.CRI)),
+	}
 
-		machineVersion, hasDeprecatedVersion, err := latestMatchingMachineVersion(availableMachineVersions, providedVersionMin, *machineOSName, currentMachineImage)
-		if err != nil {
-			return nil, nil, fmt.Errorf("getting latest matching machine image version: %w", err)
-		}
-		if hasDeprecatedVersion && machineVersion != nil {
-			deprecatedVersionsUsed = append(deprecatedVersionsUsed, *machineVersion)
-		}
-
-		cnp := ske.Nodepool{
-			Name:           name,
-			Minimum:        conversion.Int64ValueToPointer(nodePool.Minimum),
-			Maximum:        conversion.Int64ValueToPointer(nodePool.Maximum),
-			MaxSurge:       conversion.Int64ValueToPointer(nodePool.MaxSurge),
-			MaxUnavailable: conversion.Int64ValueToPointer(nodePool.MaxUnavailable),
-			Machine: &ske.Machine{
-				Type: conversion.StringValueToPointer(nodePool.MachineType),
-				Image: &ske.Image{
-					Name:    machineOSName,
-					Version: machineVersion,
-				},
-			},
-			Volume: &ske.Volume{
-				Type: conversion.StringValueToPointer(nodePool.VolumeType),
-				Size: conversion.Int64ValueToPointer(nodePool.VolumeSize),
-			},
-			Taints:                &ts,
-			Cri:                   &cn,
-			Labels:                ls,
-			AvailabilityZones:     &zs,
-			AllowSystemComponents: conversion.BoolValueToPointer(nodePool.AllowSystemComponents),
+	providedVersionMin := conversion.StringValueToPointer(nodePool.OSVersionMin)
+	if !nodePool.OSVersion.IsNull() {
+		if providedVersionMin != nil {
+			return nil, nil, fmt.Errorf("both `os_version` and `os_version_min` are set for for node_pool %q. Please use `os_version_min` only, `os_version` is deprecated", *name)
 		}
-		cnps = append(cnps, cnp)
+		// os_version field deprecation
+		// this if clause should be removed once os_version field is completely removed
+		// os_version field value is used as minimum os version
+		providedVersionMin = conversion.StringValueToPointer(nodePool.OSVersion)
 	}
 
-	if err := verifySystemComponentsInNodePools(cnps); err != nil {
-		return nil, nil, err
+	machineOSName := conversion.StringValueToPointer(nodePool.OSName)
+	if machineOSName == nil {
+		return nil, nil, fmt.Errorf("found nil machine name for node_pool %q", *name)
 	}
 
-	return cnps, deprecatedVersionsUsed, nil
+	machineVersion, hasDeprecatedVersion, err := latestMatchingMachineVersion(availableMachineVersions, providedVersionMin, *machineOSName, currentMachineImage)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting latest matching machine image version: %w", err)
+	}
+	if hasDeprecatedVersion && machineVersion != nil {
+		deprecatedVersion = machineVersion
+	}
+
+	cnp = &ske.Nodepool{
+		Name:           name,
+		Minimum:        conversion.Int64ValueToPointer(nodePool.Minimum),
+		Maximum:        conversion.Int64ValueToPointer(nodePool.Maximum),
+		MaxSurge:       conversion.Int64ValueToPointer(nodePool.MaxSurge),
+		MaxUnavailable: conversion.Int64ValueToPointer(nodePool.MaxUnavailable),
+		Machine: &ske.Machine{
+			Type: conversion.StringValueToPointer(nodePool.MachineType),
+			Image: &ske.Image{
+				Name:    machineOSName,
+				Version: machineVersion,
+			},
+		},
+		Volume: &ske.Volume{
+			Type: conversion.StringValueToPointer(nodePool.VolumeType),
+			Size: conversion.Int64ValueToPointer(nodePool.VolumeSize),
+		},
+		Taints:                &ts,
+		Cri:                   &cn,
+		Labels:                ls,
+		AvailabilityZones:     &zs,
+		AllowSystemComponents: conversion.BoolValueToPointer(nodePool.AllowSystemComponents),
+	}
+	return cnp, deprecatedVersion, nil
 }
 
 // verifySystemComponentsInNodePools checks if at least one node pool has the allow_system_components attribute set to true.
@@ -1437,78 +1628,92 @@ func mapNodePools(ctx context.Context, cl *ske.Cluster, model *Model) error {
 
 	nodePools := []attr.Value{}
 	for i, nodePoolResp := range *cl.Nodepools {
-		nodePool := map[string]attr.Value{
-			"name":                    types.StringPointerValue(nodePoolResp.Name),
-			"machine_type":            types.StringPointerValue(nodePoolResp.Machine.Type),
-			"os_name":                 types.StringNull(),
-			"os_version_min":          modelNodePoolOSVersionMin[*nodePoolResp.Name],
-			"os_version":              modelNodePoolOSVersion[*nodePoolResp.Name],
-			"minimum":                 types.Int64PointerValue(nodePoolResp.Minimum),
-			"maximum":                 types.Int64PointerValue(nodePoolResp.Maximum),
-			"max_surge":               types.Int64PointerValue(nodePoolResp.MaxSurge),
-			"max_unavailable":         types.Int64PointerValue(nodePoolResp.MaxUnavailable),
-			"volume_type":             types.StringNull(),
-			"volume_size":             types.Int64PointerValue(nodePoolResp.Volume.Size),
-			"labels":                  types.MapNull(types.StringType),
-			"cri":                     types.StringNull(),
-			"availability_zones":      types.ListNull(types.StringType),
-			"allow_system_components": types.BoolPointerValue(nodePoolResp.AllowSystemComponents),
-		}
-
-		if nodePoolResp.Machine != nil && nodePoolResp.Machine.Image != nil {
-			nodePool["os_name"] = types.StringPointerValue(nodePoolResp.Machine.Image.Name)
-			nodePool["os_version_used"] = types.StringPointerValue(nodePoolResp.Machine.Image.Version)
-		}
-
-		if nodePoolResp.Volume != nil {
-			nodePool["volume_type"] = types.StringPointerValue(nodePoolResp.Volume.Type)
-		}
-
-		if nodePoolResp.Cri != nil {
-			nodePool["cri"] = types.StringValue(string(nodePoolResp.Cri.GetName()))
-		}
-
 		taintsInModel := false
 		if i < len(modelNodePools) && !modelNodePools[i].Taints.IsNull() && !modelNodePools[i].Taints.IsUnknown() {
 			taintsInModel = true
 		}
-		err := mapTaints(nodePoolResp.Taints, nodePool, taintsInModel)
+		nodePoolTF, err := mapNodePool(ctx, &nodePoolResp, modelNodePoolOSVersionMin[nodePoolResp.GetName()], modelNodePoolOSVersion[nodePoolResp.GetName()], taintsInModel)
 		if err != nil {
-			return fmt.Errorf("mapping index %d, field taints: %w", i, err)
+			return fmt.Errorf("mapping index %d: %w", i, err)
 		}
+		nodePools = append(nodePools, nodePoolTF)
+	}
+	nodePoolsTF, diags := basetypes.NewListValue(types.ObjectType{AttrTypes: nodePoolTypes}, nodePools)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.NodePools = nodePoolsTF
+	return nil
+}
 
-		if nodePoolResp.Labels != nil {
-			elems := map[string]attr.Value{}
-			for k, v := range *nodePoolResp.Labels {
-				elems[k] = types.StringValue(v)
-			}
-			elemsTF, diags := types.MapValue(types.StringType, elems)
-			if diags.HasError() {
-				return fmt.Errorf("mapping index %d, field labels: %w", i, core.DiagsToError(diags))
-			}
-			nodePool["labels"] = elemsTF
+// mapNodePool maps a single node pool of the API response. The configured OS versions are kept, since the API only returns the used version.
+func mapNodePool(ctx context.Context, nodePoolResp *ske.Nodepool, osVersionMin, osVersion types.String, taintsInModel bool) (basetypes.ObjectValue, error) {
+	nodePool := map[string]attr.Value{
+		"name":                    types.StringPointerValue(nodePoolResp.Name),
+		"machine_type":            types.StringNull(),
+		"os_name":                 types.StringNull(),
+		"os_version_min":          osVersionMin,
+		"os_version":              osVersion,
+		"os_version_used":         types.StringNull(),
+		"minimum":                 types.Int64PointerValue(nodePoolResp.Minimum),
+		"maximum":                 types.Int64PointerValue(nodePoolResp.Maximum),
+		"max_surge":               types.Int64PointerValue(nodePoolResp.MaxSurge),
+		"max_unavailable":         types.Int64PointerValue(nodePoolResp.MaxUnavailable),
+		"volume_type":             types.StringNull(),
+		"volume_size":             types.Int64Null(),
+		"labels":                  types.MapNull(types.StringType),
+		"cri":                     types.StringNull(),
+		"availability_zones":      types.ListNull(types.StringType),
+		"allow_system_components": types.BoolPointerValue(nodePoolResp.AllowSystemComponents),
+	}
+
+	if nodePoolResp.Machine != nil {
+		nodePool["machine_type"] = types.StringPointerValue(nodePoolResp.Machine.Type)
+		if nodePoolResp.Machine.Image != nil {
+			nodePool["os_name"] = types.StringPointerValue(nodePoolResp.Machine.Image.Name)
+			nodePool["os_version_used"] = types.StringPointerValue(nodePoolResp.Machine.Image.Version)
 		}
+	}
 
-		if nodePoolResp.AvailabilityZones != nil {
-			elemsTF, diags := types.ListValueFrom(ctx, types.StringType, *nodePoolResp.AvailabilityZones)
-			if diags.HasError() {
-				return fmt.Errorf("mapping index %d, field availability_zones: %w", i, core.DiagsToError(diags))
-			}
-			nodePool["availability_zones"] = elemsTF
+	if nodePoolResp.Volume != nil {
+		nodePool["volume_type"] = types.StringPointerValue(nodePoolResp.Volume.Type)
+		nodePool["volume_size"] = types.Int64PointerValue(nodePoolResp.Volume.Size)
+	}
+
+	if nodePoolResp.Cri != nil {
+		nodePool["cri"] = types.StringValue(string(nodePoolResp.Cri.GetName()))
+	}
+
+	err := mapTaints(nodePoolResp.Taints, nodePool, taintsInModel)
+	if err != nil {
+		return basetypes.ObjectValue{}, fmt.Errorf("field taints: %w", err)
+	}
+
+	if nodePoolResp.Labels != nil {
+		elems := map[string]attr.Value{}
+		for k, v := range *nodePoolResp.Labels {
+			elems[k] = types.StringValue(v)
+		}
+		elemsTF, diags := types.MapValue(types.StringType, elems)
+		if diags.HasError() {
+			return basetypes.ObjectValue{}, fmt.Errorf("field labels: %w", core.DiagsToError(diags))
 		}
+		nodePool["labels"] = elemsTF
+	}
 
-		nodePoolTF, diags := basetypes.NewObjectValue(nodePoolTypes, nodePool)
+	if nodePoolResp.AvailabilityZones != nil {
+		elemsTF, diags := types.ListValueFrom(ctx, types.StringType, *nodePoolResp.AvailabilityZones)
 		if diags.HasError() {
-			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+			return basetypes.ObjectValue{}, fmt.Errorf("field availability_zones: %w", core.DiagsToError(diags))
 		}
-		nodePools = append(nodePools, nodePoolTF)
+		nodePool["availability_zones"] = elemsTF
 	}
-	nodePoolsTF, diags := basetypes.NewListValue(types.ObjectType{AttrTypes: nodePoolTypes}, nodePools)
+
+	nodePoolTF, diags := basetypes.NewObjectValue(nodePoolTypes, nodePool)
 	if diags.HasError() {
-		return core.DiagsToError(diags)
+		return basetypes.ObjectValue{}, core.DiagsToError(diags)
 	}
-	model.NodePools = nodePoolsTF
-	return nil
+	return nodePoolTF, nil
 }
 
 func mapTaints(t *[]ske.Taint, nodePool map[string]attr.Value, existInModel bool) error {
@@ -2088,7 +2293,7 @@ func getLatestSupportedKubernetesVersion(versions []ske.KubernetesVersion) (*str
 }
 
 func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var state Model
+	var state ResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -2112,11 +2317,12 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	err = mapFields(ctx, clResp, &state, region)
+	err = mapFields(ctx, clResp, &state.Model, region)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading cluster", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	mapDesiredState(clResp, &state)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -2127,7 +2333,7 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -2147,13 +2353,28 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	currentKubernetesVersion, currentMachineImages := getCurrentVersions(ctx, r.skeClient, &model)
+	// a hibernated cluster is woken up before it is updated, and hibernated only after the update
+	if model.DesiredState.ValueString() == DesiredStateRunning {
+		r.updateClusterState(ctx, &resp.Diagnostics, &model)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableKubernetesVersions, availableMachines, currentKubernetesVersion, currentMachineImages)
+	currentKubernetesVersion, currentMachineImages := getCurrentVersions(ctx, r.skeClient, &model.Model)
+
+	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model.Model, availableKubernetesVersions, availableMachines, currentKubernetesVersion, currentMachineImages)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if model.DesiredState.ValueString() == DesiredStateHibernated {
+		r.updateClusterState(ctx, &resp.Diagnostics, &model)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -2163,7 +2384,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -2637,3 +2637,197 @@ func TestValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterStateTransition(t *testing.T) {
+	tests := []struct {
+		description  string
+		input        *ske.Cluster
+		desiredState string
+		expected     string
+	}{
+		{
+			"hibernate_running_cluster",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					Hibernated: utils.Ptr(false),
+				},
+			},
+			DesiredStateHibernated,
+			DesiredStateHibernated,
+		},
+		{
+			"hibernate_hibernated_cluster",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					Hibernated: utils.Ptr(true),
+				},
+			},
+			DesiredStateHibernated,
+			"",
+		},
+		{
+			"wake_up_hibernated_cluster",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					Hibernated: utils.Ptr(true),
+				},
+			},
+			DesiredStateRunning,
+			DesiredStateRunning,
+		},
+		{
+			"wake_up_running_cluster",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					Hibernated: utils.Ptr(false),
+				},
+			},
+			DesiredStateRunning,
+			"",
+		},
+		{
+			"no_status",
+			&ske.Cluster{},
+			DesiredStateHibernated,
+			DesiredStateHibernated,
+		},
+		{
+			"no_desired_state",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					Hibernated: utils.Ptr(true),
+				},
+			},
+			"",
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := clusterStateTransition(tt.input, tt.desiredState)
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestMapDesiredState(t *testing.T) {
+	tests := []struct {
+		description  string
+		input        *ske.Cluster
+		desiredState types.String
+		expected     types.String
+	}{
+		{
+			"hibernated_cluster",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					Hibernated: utils.Ptr(true),
+				},
+			},
+			types.StringValue(DesiredStateRunning),
+			types.StringValue(DesiredStateHibernated),
+		},
+		{
+			"running_cluster",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					Hibernated: utils.Ptr(false),
+				},
+			},
+			types.StringValue(DesiredStateHibernated),
+			types.StringValue(DesiredStateRunning),
+		},
+		{
+			"no_status",
+			&ske.Cluster{},
+			types.StringValue(DesiredStateHibernated),
+			types.StringValue(DesiredStateRunning),
+		},
+		{
+			"desired_state_unset",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					Hibernated: utils.Ptr(true),
+				},
+			},
+			types.StringNull(),
+			types.StringNull(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &ResourceModel{DesiredState: tt.desiredState}
+			mapDesiredState(tt.input, model)
+			diff := cmp.Diff(model.DesiredState, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNodeRollChanges(t *testing.T) {
+	stateNodePool := nodePool{
+		Name:         types.StringValue("np"),
+		MachineType:  types.StringValue("c1.2"),
+		OSName:       types.StringValue("flatcar"),
+		OSVersionMin: types.StringValue("3815.2.5"),
+		OSVersion:    types.StringNull(),
+		VolumeType:   types.StringValue("storage_premium_perf1"),
+		VolumeSize:   types.Int64Value(40),
+		CRI:          types.StringValue("containerd"),
+		Labels:       types.MapNull(types.StringType),
+		Minimum:      types.Int64Value(1),
+		Maximum:      types.Int64Value(3),
+	}
+	tests := []struct {
+		description string
+		modify      func(np *nodePool)
+		expected    []string
+	}{
+		{
+			"no_changes",
+			func(_ *nodePool) {},
+			[]string{},
+		},
+		{
+			"in_place_changes",
+			func(np *nodePool) {
+				np.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")})
+				np.Minimum = types.Int64Value(2)
+				np.Maximum = types.Int64Value(5)
+			},
+			[]string{},
+		},
+		{
+			"rolling_changes",
+			func(np *nodePool) {
+				np.MachineType = types.StringValue("c1.4")
+				np.VolumeSize = types.Int64Value(60)
+				np.CRI = types.StringValue("docker")
+			},
+			[]string{"machine_type", "volume_size", "cri"},
+		},
+		{
+			"unknown_and_null_values",
+			func(np *nodePool) {
+				np.OSVersionMin = types.StringUnknown()
+				np.VolumeType = types.StringNull()
+			},
+			[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			planNodePool := stateNodePool
+			tt.modify(&planNodePool)
+			changes := nodeRollChanges(&stateNodePool, &planNodePool)
+			diff := cmp.Diff(changes, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
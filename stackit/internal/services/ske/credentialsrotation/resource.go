@@ -0,0 +1,398 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	skeWait "github.com/stackitcloud/stackit-sdk-go/services/ske/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &credentialsRotationResource{}
+	_ resource.ResourceWithConfigure  = &credentialsRotationResource{}
+	_ resource.ResourceWithModifyPlan = &credentialsRotationResource{}
+)
+
+type Model struct {
+	Id              types.String `tfsdk:"id"` // needed by TF
+	ProjectId       types.String `tfsdk:"project_id"`
+	Region          types.String `tfsdk:"region"`
+	ClusterName     types.String `tfsdk:"cluster_name"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+	Complete        types.Bool   `tfsdk:"complete"`
+	RotationPhase   types.String `tfsdk:"rotation_phase"`
+	LastInitiated   types.String `tfsdk:"last_initiated"`
+	LastCompleted   types.String `tfsdk:"last_completed"`
+}
+
+// NewCredentialsRotationResource is a helper function to simplify the provider implementation.
+func NewCredentialsRotationResource() resource.Resource {
+	return &credentialsRotationResource{}
+}
+
+// credentialsRotationResource is the resource implementation.
+type credentialsRotationResource struct {
+	client       *ske.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *credentialsRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_credentials_rotation"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *credentialsRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := skeUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "SKE credentials rotation client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *credentialsRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "SKE credentials rotation resource schema. Starts a rotation of the cluster CA and service account keys on creation and whenever `rotation_trigger` changes. Must have a `region` specified in the provider configuration.",
+		"note": "After the rotation is prepared, both the old and the new credentials are valid. Completing the rotation invalidates the old credentials, so all kubeconfigs of the cluster must be renewed first. " +
+			"Deleting this resource only removes it from the Terraform state.",
+		"id":               "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`cluster_name`\".",
+		"project_id":       "STACKIT project ID to which the cluster is associated.",
+		"cluster_name":     "Name of the SKE cluster.",
+		"region":           "The resource region. If not defined, the provider region is used.",
+		"rotation_trigger": "Arbitrary value that starts a new credentials rotation when changed, e.g. a timestamp.",
+		"complete":         "If set to true, the rotation is completed after it was prepared. Setting it to true on an existing resource completes a prepared rotation. Defaults to `false`.",
+		"rotation_phase":   "Phase of the credentials rotation. " + utils.SupportedValuesDocumentation(credentialsRotationPhases()),
+		"last_initiated":   "Date-time when the last credentials rotation was started.",
+		"last_completed":   "Date-time when the last credentials rotation was completed.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("%s\n%s", descriptions["main"], descriptions["note"]),
+		// Callout block: https://developer.hashicorp.com/terraform/registry/providers/docs#callouts
+		MarkdownDescription: fmt.Sprintf("%s\n\n~> %s", descriptions["main"], descriptions["note"]),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				Description: descriptions["cluster_name"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: descriptions["region"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Description: descriptions["rotation_trigger"],
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"complete": schema.BoolAttribute{
+				Description: descriptions["complete"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"rotation_phase": schema.StringAttribute{
+				Description: descriptions["rotation_phase"],
+				Computed:    true,
+			},
+			"last_initiated": schema.StringAttribute{
+				Description: descriptions["last_initiated"],
+				Computed:    true,
+			},
+			"last_completed": schema.StringAttribute{
+				Description: descriptions["last_completed"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *credentialsRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create starts a credentials rotation and sets the initial Terraform state.
+func (r *credentialsRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	region := model.Region.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	_, err := r.client.StartCredentialsRotation(ctx, projectId, region, clusterName).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error starting credentials rotation", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	cluster, err := skeWait.StartCredentialsRotationWaitHandler(ctx, r.client, projectId, region, clusterName).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error starting credentials rotation", fmt.Sprintf("Credentials rotation preparation waiting: %v", err))
+		return
+	}
+
+	if model.Complete.ValueBool() {
+		cluster, err = r.completeRotation(ctx, projectId, region, clusterName)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error completing credentials rotation", err.Error())
+			return
+		}
+	}
+
+	err = mapFields(cluster, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error starting credentials rotation", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE credentials rotation started")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *credentialsRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	cluster, err := r.client.GetCluster(ctx, projectId, region, clusterName).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading credentials rotation", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(cluster, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading credentials rotation", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE credentials rotation read")
+}
+
+// Update completes a prepared credentials rotation if `complete` is set. All other fields require a replacement.
+func (r *credentialsRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	region := model.Region.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	cluster, err := r.client.GetCluster(ctx, projectId, region, clusterName).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating credentials rotation", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	if model.Complete.ValueBool() && getRotationPhase(cluster) == ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED {
+		cluster, err = r.completeRotation(ctx, projectId, region, clusterName)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error completing credentials rotation", err.Error())
+			return
+		}
+	}
+
+	err = mapFields(cluster, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating credentials rotation", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE credentials rotation updated")
+}
+
+// Delete removes the resource from the Terraform state. A credentials rotation can't be undone.
+func (r *credentialsRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "project_id", model.ProjectId.ValueString())
+	ctx = tflog.SetField(ctx, "cluster_name", model.ClusterName.ValueString())
+	ctx = tflog.SetField(ctx, "region", model.Region.ValueString())
+
+	// the rotation is removed from the state automatically
+	tflog.Info(ctx, "SKE credentials rotation deleted")
+}
+
+func (r *credentialsRotationResource) completeRotation(ctx context.Context, projectId, region, clusterName string) (*ske.Cluster, error) {
+	_, err := r.client.CompleteCredentialsRotation(ctx, projectId, region, clusterName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("calling API: %w", err)
+	}
+
+	cluster, err := skeWait.CompleteCredentialsRotationWaitHandler(ctx, r.client, projectId, region, clusterName).WaitWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("credentials rotation completion waiting: %w", err)
+	}
+	return cluster, nil
+}
+
+func mapFields(cluster *ske.Cluster, model *Model, region string) error {
+	if cluster == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.ClusterName.ValueString())
+	model.Region = types.StringValue(region)
+
+	model.RotationPhase = types.StringNull()
+	model.LastInitiated = types.StringNull()
+	model.LastCompleted = types.StringNull()
+	if cluster.Status == nil || cluster.Status.CredentialsRotation == nil {
+		return nil
+	}
+	rotation := cluster.Status.CredentialsRotation
+	if rotation.Phase != nil {
+		model.RotationPhase = types.StringValue(string(*rotation.Phase))
+	}
+	if rotation.LastInitiationTime != nil {
+		model.LastInitiated = types.StringValue(rotation.LastInitiationTime.Format(time.RFC3339))
+	}
+	if rotation.LastCompletionTime != nil {
+		model.LastCompleted = types.StringValue(rotation.LastCompletionTime.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func getRotationPhase(cluster *ske.Cluster) ske.CredentialsRotationStatePhase {
+	if cluster == nil || cluster.Status == nil || cluster.Status.CredentialsRotation == nil {
+		return ""
+	}
+	return cluster.Status.CredentialsRotation.GetPhase()
+}
+
+func credentialsRotationPhases() []string {
+	phases := []string{}
+	for _, phase := range ske.AllowedCredentialsRotationStatePhaseEnumValues {
+		phases = append(phases, string(phase))
+	}
+	return phases
+}
@@ -0,0 +1,165 @@
+package ske
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+const testRegion = "eu01"
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       Model
+		input       *ske.Cluster
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			Model{
+				ProjectId:   types.StringValue("pid"),
+				ClusterName: types.StringValue("cluster"),
+			},
+			&ske.Cluster{},
+			Model{
+				Id:            types.StringValue("pid,eu01,cluster"),
+				ProjectId:     types.StringValue("pid"),
+				Region:        types.StringValue(testRegion),
+				ClusterName:   types.StringValue("cluster"),
+				RotationPhase: types.StringNull(),
+				LastInitiated: types.StringNull(),
+				LastCompleted: types.StringNull(),
+			},
+			true,
+		},
+		{
+			"prepared",
+			Model{
+				ProjectId:       types.StringValue("pid"),
+				ClusterName:     types.StringValue("cluster"),
+				RotationTrigger: types.StringValue("trigger"),
+				Complete:        types.BoolValue(false),
+			},
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					CredentialsRotation: &ske.CredentialsRotationState{
+						Phase:              ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED.Ptr(),
+						LastInitiationTime: utils.Ptr(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+					},
+				},
+			},
+			Model{
+				Id:              types.StringValue("pid,eu01,cluster"),
+				ProjectId:       types.StringValue("pid"),
+				Region:          types.StringValue(testRegion),
+				ClusterName:     types.StringValue("cluster"),
+				RotationTrigger: types.StringValue("trigger"),
+				Complete:        types.BoolValue(false),
+				RotationPhase:   types.StringValue("PREPARED"),
+				LastInitiated:   types.StringValue("2025-01-02T03:04:05Z"),
+				LastCompleted:   types.StringNull(),
+			},
+			true,
+		},
+		{
+			"completed",
+			Model{
+				ProjectId:   types.StringValue("pid"),
+				ClusterName: types.StringValue("cluster"),
+				Complete:    types.BoolValue(true),
+			},
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					CredentialsRotation: &ske.CredentialsRotationState{
+						Phase:              ske.CREDENTIALSROTATIONSTATEPHASE_COMPLETED.Ptr(),
+						LastInitiationTime: utils.Ptr(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+						LastCompletionTime: utils.Ptr(time.Date(2025, 1, 2, 4, 4, 5, 0, time.UTC)),
+					},
+				},
+			},
+			Model{
+				Id:            types.StringValue("pid,eu01,cluster"),
+				ProjectId:     types.StringValue("pid"),
+				Region:        types.StringValue(testRegion),
+				ClusterName:   types.StringValue("cluster"),
+				Complete:      types.BoolValue(true),
+				RotationPhase: types.StringValue("COMPLETED"),
+				LastInitiated: types.StringValue("2025-01-02T03:04:05Z"),
+				LastCompleted: types.StringValue("2025-01-02T04:04:05Z"),
+			},
+			true,
+		},
+		{
+			"nil_response",
+			Model{
+				ProjectId:   types.StringValue("pid"),
+				ClusterName: types.StringValue("cluster"),
+			},
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := tt.state
+			err := mapFields(tt.input, &state, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestGetRotationPhase(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ske.Cluster
+		expected    ske.CredentialsRotationStatePhase
+	}{
+		{
+			"prepared",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					CredentialsRotation: &ske.CredentialsRotationState{
+						Phase: ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED.Ptr(),
+					},
+				},
+			},
+			ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED,
+		},
+		{
+			"no_status",
+			&ske.Cluster{},
+			"",
+		},
+		{
+			"nil_cluster",
+			nil,
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := getRotationPhase(tt.input)
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
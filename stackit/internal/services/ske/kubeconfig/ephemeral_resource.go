@@ -0,0 +1,348 @@
+package ske
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultEphemeralExpiration int64 = 3600
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &kubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &kubeconfigEphemeralResource{}
+)
+
+type EphemeralModel struct {
+	ProjectId            types.String `tfsdk:"project_id"`
+	ClusterName          types.String `tfsdk:"cluster_name"`
+	Region               types.String `tfsdk:"region"`
+	Expiration           types.Int64  `tfsdk:"expiration"`
+	Login                types.Bool   `tfsdk:"login"`
+	Kubeconfig           types.String `tfsdk:"kube_config"`
+	ExpiresAt            types.String `tfsdk:"expires_at"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+}
+
+// NewKubeconfigEphemeralResource is a helper function to simplify the provider implementation.
+func NewKubeconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &kubeconfigEphemeralResource{}
+}
+
+// kubeconfigEphemeralResource is the ephemeral resource implementation.
+type kubeconfigEphemeralResource struct {
+	client       *ske.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *kubeconfigEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_kubeconfig"
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *kubeconfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := skeUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "SKE kubeconfig client configured")
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *kubeconfigEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":                   "SKE kubeconfig ephemeral resource schema. Returns a short-lived kubeconfig that is only available during the current Terraform run and is never stored in the state or plan. Requires Terraform 1.10 or later. Must have a `region` specified in the provider configuration.",
+		"project_id":             "STACKIT project ID to which the cluster is associated.",
+		"cluster_name":           "Name of the SKE cluster.",
+		"region":                 "The resource region. If not defined, the provider region is used.",
+		"expiration":             "Expiration time of the admin kubeconfig, in seconds. Defaults to `3600`. Not used for `login` kubeconfigs.",
+		"login":                  "If set to true, a login kubeconfig is returned. It contains no admin credentials and authenticates with the `stackit ske kubeconfig login` exec plugin of the STACKIT CLI instead. Defaults to `false`.",
+		"kube_config":            "Raw kubeconfig.",
+		"expires_at":             "Timestamp when the admin kubeconfig expires. Not set for `login` kubeconfigs.",
+		"host":                   "Kubernetes API server URL of the cluster.",
+		"cluster_ca_certificate": "PEM-encoded CA certificate of the cluster.",
+		"client_certificate":     "PEM-encoded client certificate. Not set for `login` kubeconfigs.",
+		"client_key":             "PEM-encoded client key. Not set for `login` kubeconfigs.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				Description: descriptions["cluster_name"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: descriptions["region"],
+			},
+			"expiration": schema.Int64Attribute{
+				Description: descriptions["expiration"],
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"login": schema.BoolAttribute{
+				Description: descriptions["login"],
+				Optional:    true,
+			},
+			"kube_config": schema.StringAttribute{
+				Description: descriptions["kube_config"],
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: descriptions["expires_at"],
+				Computed:    true,
+			},
+			"host": schema.StringAttribute{
+				Description: descriptions["host"],
+				Computed:    true,
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Description: descriptions["cluster_ca_certificate"],
+				Computed:    true,
+			},
+			"client_certificate": schema.StringAttribute{
+				Description: descriptions["client_certificate"],
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: descriptions["client_key"],
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Open creates a new kubeconfig for the current Terraform run.
+func (r *kubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model EphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	if model.Login.ValueBool() {
+		loginKubeconfigResp, err := r.client.GetLoginKubeconfig(ctx, projectId, region, clusterName).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error opening kubeconfig", fmt.Sprintf("Calling API: %v", err))
+			return
+		}
+		err = mapLoginKubeconfigFields(loginKubeconfigResp, &model, region)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error opening kubeconfig", fmt.Sprintf("Processing API payload: %v", err))
+			return
+		}
+	} else {
+		expiration := defaultEphemeralExpiration
+		if !model.Expiration.IsNull() && !model.Expiration.IsUnknown() {
+			expiration = model.Expiration.ValueInt64()
+		}
+		payload := ske.CreateKubeconfigPayload{
+			ExpirationSeconds: sdkUtils.Ptr(strconv.FormatInt(expiration, 10)),
+		}
+		kubeconfigResp, err := r.client.CreateKubeconfig(ctx, projectId, region, clusterName).CreateKubeconfigPayload(payload).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error opening kubeconfig", fmt.Sprintf("Calling API: %v", err))
+			return
+		}
+		err = mapEphemeralFields(kubeconfigResp, &model, region)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error opening kubeconfig", fmt.Sprintf("Processing API payload: %v", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE kubeconfig opened")
+}
+
+func mapEphemeralFields(kubeconfigResp *ske.Kubeconfig, model *EphemeralModel, region string) error {
+	if kubeconfigResp == nil {
+		return fmt.Errorf("response is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if kubeconfigResp.Kubeconfig == nil {
+		return fmt.Errorf("kubeconfig not present")
+	}
+
+	model.Region = types.StringValue(region)
+	model.Kubeconfig = types.StringPointerValue(kubeconfigResp.Kubeconfig)
+	model.ExpiresAt = types.StringNull()
+	if kubeconfigResp.ExpirationTimestamp != nil {
+		model.ExpiresAt = types.StringValue(kubeconfigResp.ExpirationTimestamp.Format(time.RFC3339))
+	}
+	return mapKubeconfigDetails(*kubeconfigResp.Kubeconfig, model)
+}
+
+func mapLoginKubeconfigFields(loginKubeconfigResp *ske.LoginKubeconfig, model *EphemeralModel, region string) error {
+	if loginKubeconfigResp == nil {
+		return fmt.Errorf("response is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if loginKubeconfigResp.Kubeconfig == nil {
+		return fmt.Errorf("kubeconfig not present")
+	}
+
+	model.Region = types.StringValue(region)
+	model.Kubeconfig = types.StringPointerValue(loginKubeconfigResp.Kubeconfig)
+	model.ExpiresAt = types.StringNull()
+	return mapKubeconfigDetails(*loginKubeconfigResp.Kubeconfig, model)
+}
+
+// kubeconfigFile contains the parts of a kubeconfig file needed to configure other providers
+type kubeconfigFile struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// mapKubeconfigDetails parses the kubeconfig and sets the connection details of its current context.
+// If no current context is set, the first context is used.
+func mapKubeconfigDetails(kubeconfig string, model *EphemeralModel) error {
+	var file kubeconfigFile
+	if err := yaml.Unmarshal([]byte(kubeconfig), &file); err != nil {
+		return fmt.Errorf("parsing kubeconfig: %w", err)
+	}
+	if len(file.Contexts) == 0 {
+		return fmt.Errorf("kubeconfig has no contexts")
+	}
+
+	contextIdx := 0
+	for i := range file.Contexts {
+		if file.Contexts[i].Name == file.CurrentContext {
+			contextIdx = i
+			break
+		}
+	}
+	clusterName := file.Contexts[contextIdx].Context.Cluster
+	userName := file.Contexts[contextIdx].Context.User
+
+	model.Host = types.StringNull()
+	model.ClusterCACertificate = types.StringNull()
+	model.ClientCertificate = types.StringNull()
+	model.ClientKey = types.StringNull()
+
+	for i := range file.Clusters {
+		if file.Clusters[i].Name != clusterName {
+			continue
+		}
+		cluster := file.Clusters[i].Cluster
+		if cluster.Server != "" {
+			model.Host = types.StringValue(cluster.Server)
+		}
+		caCertificate, err := decodeBase64Field(cluster.CertificateAuthorityData)
+		if err != nil {
+			return fmt.Errorf("decoding certificate-authority-data: %w", err)
+		}
+		model.ClusterCACertificate = caCertificate
+	}
+
+	for i := range file.Users {
+		if file.Users[i].Name != userName {
+			continue
+		}
+		user := file.Users[i].User
+		clientCertificate, err := decodeBase64Field(user.ClientCertificateData)
+		if err != nil {
+			return fmt.Errorf("decoding client-certificate-data: %w", err)
+		}
+		model.ClientCertificate = clientCertificate
+		clientKey, err := decodeBase64Field(user.ClientKeyData)
+		if err != nil {
+			return fmt.Errorf("decoding client-key-data: %w", err)
+		}
+		model.ClientKey = clientKey
+	}
+	return nil
+}
+
+func decodeBase64Field(value string) (types.String, error) {
+	if value == "" {
+		return types.StringNull(), nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(string(decoded)), nil
+}
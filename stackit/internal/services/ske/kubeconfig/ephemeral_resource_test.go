@@ -0,0 +1,237 @@
+package ske
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+var (
+	testCA         = base64.StdEncoding.EncodeToString([]byte("ca"))
+	testClientCert = base64.StdEncoding.EncodeToString([]byte("cert"))
+	testClientKey  = base64.StdEncoding.EncodeToString([]byte("key"))
+
+	testAdminKubeconfig = `apiVersion: v1
+kind: Config
+current-context: admin
+clusters:
+- name: other
+  cluster:
+    server: https://other.example.com
+- name: cluster
+  cluster:
+    server: https://api.cluster.example.com
+    certificate-authority-data: ` + testCA + `
+contexts:
+- name: other
+  context:
+    cluster: other
+    user: other
+- name: admin
+  context:
+    cluster: cluster
+    user: admin
+users:
+- name: other
+  user: {}
+- name: admin
+  user:
+    client-certificate-data: ` + testClientCert + `
+    client-key-data: ` + testClientKey + `
+`
+
+	testLoginKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: cluster
+  cluster:
+    server: https://api.cluster.example.com
+    certificate-authority-data: ` + testCA + `
+contexts:
+- name: cluster
+  context:
+    cluster: cluster
+    user: login
+users:
+- name: login
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: stackit
+      args:
+      - ske
+      - kubeconfig
+      - login
+`
+)
+
+func TestMapEphemeralFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       EphemeralModel
+		input       *ske.Kubeconfig
+		expected    EphemeralModel
+		isValid     bool
+	}{
+		{
+			"admin_kubeconfig",
+			EphemeralModel{
+				Region: types.StringNull(),
+			},
+			&ske.Kubeconfig{
+				ExpirationTimestamp: utils.Ptr(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+				Kubeconfig:          utils.Ptr(testAdminKubeconfig),
+			},
+			EphemeralModel{
+				Region:               types.StringValue("eu01"),
+				Kubeconfig:           types.StringValue(testAdminKubeconfig),
+				ExpiresAt:            types.StringValue("2025-01-02T03:04:05Z"),
+				Host:                 types.StringValue("https://api.cluster.example.com"),
+				ClusterCACertificate: types.StringValue("ca"),
+				ClientCertificate:    types.StringValue("cert"),
+				ClientKey:            types.StringValue("key"),
+			},
+			true,
+		},
+		{
+			"region_set",
+			EphemeralModel{
+				Region: types.StringValue("eu01"),
+			},
+			&ske.Kubeconfig{
+				Kubeconfig: utils.Ptr(testAdminKubeconfig),
+			},
+			EphemeralModel{
+				Region:               types.StringValue("eu01"),
+				Kubeconfig:           types.StringValue(testAdminKubeconfig),
+				ExpiresAt:            types.StringNull(),
+				Host:                 types.StringValue("https://api.cluster.example.com"),
+				ClusterCACertificate: types.StringValue("ca"),
+				ClientCertificate:    types.StringValue("cert"),
+				ClientKey:            types.StringValue("key"),
+			},
+			true,
+		},
+		{
+			"invalid_kubeconfig",
+			EphemeralModel{},
+			&ske.Kubeconfig{
+				Kubeconfig: utils.Ptr("not: [valid"),
+			},
+			EphemeralModel{},
+			false,
+		},
+		{
+			"no_kubeconfig",
+			EphemeralModel{},
+			&ske.Kubeconfig{},
+			EphemeralModel{},
+			false,
+		},
+		{
+			"nil_response",
+			EphemeralModel{},
+			nil,
+			EphemeralModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := tt.state
+			err := mapEphemeralFields(tt.input, &model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMapLoginKubeconfigFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ske.LoginKubeconfig
+		expected    EphemeralModel
+		isValid     bool
+	}{
+		{
+			"login_kubeconfig",
+			&ske.LoginKubeconfig{
+				Kubeconfig: utils.Ptr(testLoginKubeconfig),
+			},
+			EphemeralModel{
+				Region:               types.StringValue("eu01"),
+				Kubeconfig:           types.StringValue(testLoginKubeconfig),
+				ExpiresAt:            types.StringNull(),
+				Host:                 types.StringValue("https://api.cluster.example.com"),
+				ClusterCACertificate: types.StringValue("ca"),
+				ClientCertificate:    types.StringNull(),
+				ClientKey:            types.StringNull(),
+			},
+			true,
+		},
+		{
+			"no_contexts",
+			&ske.LoginKubeconfig{
+				Kubeconfig: utils.Ptr("apiVersion: v1\nkind: Config\n"),
+			},
+			EphemeralModel{},
+			false,
+		},
+		{
+			"nil_response",
+			nil,
+			EphemeralModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := EphemeralModel{}
+			err := mapLoginKubeconfigFields(tt.input, &model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestEphemeralRegionSchema(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &ephemeral.SchemaResponse{}
+	NewKubeconfigEphemeralResource().Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	regionAttribute, ok := schemaResp.Schema.Attributes["region"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("region is not a string attribute")
+	}
+	// the region is always set in the result, so it must be computed if it isn't configured
+	if !regionAttribute.Optional || !regionAttribute.Computed {
+		t.Fatalf("region must be optional and computed")
+	}
+}
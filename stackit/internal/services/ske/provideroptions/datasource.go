@@ -0,0 +1,431 @@
+package provideroptions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &providerOptionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &providerOptionsDataSource{}
+)
+
+type DataSourceModel struct {
+	Id                 types.String `tfsdk:"id"` // needed by TF
+	Region             types.String `tfsdk:"region"`
+	KubernetesVersions types.List   `tfsdk:"kubernetes_versions"`
+	MachineImages      types.List   `tfsdk:"machine_images"`
+	MachineTypes       types.List   `tfsdk:"machine_types"`
+	VolumeTypes        types.List   `tfsdk:"volume_types"`
+	AvailabilityZones  types.List   `tfsdk:"availability_zones"`
+}
+
+// Types corresponding to a single element of DataSourceModel.KubernetesVersions
+var kubernetesVersionTypes = map[string]attr.Type{
+	"version":         types.StringType,
+	"state":           types.StringType,
+	"expiration_date": types.StringType,
+	"feature_gates":   types.MapType{ElemType: types.StringType},
+}
+
+// Types corresponding to a single element of machineImageTypes versions
+var machineImageVersionTypes = map[string]attr.Type{
+	"version":         types.StringType,
+	"state":           types.StringType,
+	"expiration_date": types.StringType,
+	"cri":             types.ListType{ElemType: types.StringType},
+}
+
+// Types corresponding to a single element of DataSourceModel.MachineImages
+var machineImageTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"versions": types.ListType{ElemType: types.ObjectType{AttrTypes: machineImageVersionTypes}},
+}
+
+// Types corresponding to a single element of DataSourceModel.MachineTypes
+var machineTypeTypes = map[string]attr.Type{
+	"name":         types.StringType,
+	"architecture": types.StringType,
+	"cpu":          types.Int64Type,
+	"gpu":          types.Int64Type,
+	"memory":       types.Int64Type,
+}
+
+// NewProviderOptionsDataSource is a helper function to simplify the provider implementation.
+func NewProviderOptionsDataSource() datasource.DataSource {
+	return &providerOptionsDataSource{}
+}
+
+// providerOptionsDataSource is the data source implementation.
+type providerOptionsDataSource struct {
+	client       *ske.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (d *providerOptionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_provider_options"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *providerOptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := skeUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "SKE client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *providerOptionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "SKE provider options data source schema. Lists the Kubernetes versions, machine images, machine types, volume types and availability zones that SKE clusters can use in a region. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`region`\".",
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "The resource region. If not defined, the provider region is used.",
+			},
+			"kubernetes_versions": schema.ListNestedAttribute{
+				Description: "The Kubernetes versions, in the order returned by the API.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Description: "The Kubernetes version.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The state of the version, e.g. `supported`, `deprecated` or `preview`.",
+							Computed:    true,
+						},
+						"expiration_date": schema.StringAttribute{
+							Description: "The date after which the version can't be used anymore, in RFC3339 format. Not set if the version doesn't expire.",
+							Computed:    true,
+						},
+						"feature_gates": schema.MapAttribute{
+							Description: "The feature gates of the version.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"machine_images": schema.ListNestedAttribute{
+				Description: "The machine images, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the OS image.",
+							Computed:    true,
+						},
+						"versions": schema.ListNestedAttribute{
+							Description: "The versions of the OS image, in the order returned by the API.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"version": schema.StringAttribute{
+										Description: "The OS image version.",
+										Computed:    true,
+									},
+									"state": schema.StringAttribute{
+										Description: "The state of the version, e.g. `supported`, `deprecated` or `preview`.",
+										Computed:    true,
+									},
+									"expiration_date": schema.StringAttribute{
+										Description: "The date after which the version can't be used anymore, in RFC3339 format. Not set if the version doesn't expire.",
+										Computed:    true,
+									},
+									"cri": schema.ListAttribute{
+										Description: "The container runtimes supported by the version.",
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"machine_types": schema.ListNestedAttribute{
+				Description: "The machine types, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the machine type.",
+							Computed:    true,
+						},
+						"architecture": schema.StringAttribute{
+							Description: "The CPU architecture of the machine type.",
+							Computed:    true,
+						},
+						"cpu": schema.Int64Attribute{
+							Description: "The number of CPUs.",
+							Computed:    true,
+						},
+						"gpu": schema.Int64Attribute{
+							Description: "The number of GPUs.",
+							Computed:    true,
+						},
+						"memory": schema.Int64Attribute{
+							Description: "The memory in GB.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"volume_types": schema.ListAttribute{
+				Description: "The names of the volume types, sorted alphabetically.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"availability_zones": schema.ListAttribute{
+				Description: "The names of the availability zones, sorted alphabetically.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *providerOptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	region := d.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	providerOptionsResp, err := d.client.ListProviderOptions(ctx, region).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading SKE provider options",
+			fmt.Sprintf("SKE provider options cannot be listed for region %q.", region),
+			map[int]string{
+				http.StatusForbidden: "Forbidden access",
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapDataSourceFields(ctx, providerOptionsResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading SKE provider options", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE provider options read")
+}
+
+func mapDataSourceFields(ctx context.Context, providerOptionsResp *ske.ProviderOptions, model *DataSourceModel, region string) error {
+	if providerOptionsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(region)
+	model.Region = types.StringValue(region)
+
+	err := mapKubernetesVersions(ctx, providerOptionsResp.GetKubernetesVersions(), model)
+	if err != nil {
+		return fmt.Errorf("mapping kubernetes versions: %w", err)
+	}
+	err = mapMachineImages(providerOptionsResp.GetMachineImages(), model)
+	if err != nil {
+		return fmt.Errorf("mapping machine images: %w", err)
+	}
+	err = mapMachineTypes(providerOptionsResp.GetMachineTypes(), model)
+	if err != nil {
+		return fmt.Errorf("mapping machine types: %w", err)
+	}
+
+	volumeTypes := []string{}
+	for _, volumeType := range providerOptionsResp.GetVolumeTypes() {
+		volumeTypes = append(volumeTypes, volumeType.GetName())
+	}
+	sort.Strings(volumeTypes)
+	volumeTypesTF, diags := types.ListValueFrom(ctx, types.StringType, volumeTypes)
+	if diags.HasError() {
+		return fmt.Errorf("mapping volume types: %w", core.DiagsToError(diags))
+	}
+	model.VolumeTypes = volumeTypesTF
+
+	availabilityZones := []string{}
+	for _, availabilityZone := range providerOptionsResp.GetAvailabilityZones() {
+		availabilityZones = append(availabilityZones, availabilityZone.GetName())
+	}
+	sort.Strings(availabilityZones)
+	availabilityZonesTF, diags := types.ListValueFrom(ctx, types.StringType, availabilityZones)
+	if diags.HasError() {
+		return fmt.Errorf("mapping availability zones: %w", core.DiagsToError(diags))
+	}
+	model.AvailabilityZones = availabilityZonesTF
+	return nil
+}
+
+func mapKubernetesVersions(ctx context.Context, kubernetesVersions []ske.KubernetesVersion, model *DataSourceModel) error {
+	kubernetesVersionsList := []attr.Value{}
+	for i, kubernetesVersion := range kubernetesVersions {
+		featureGates := types.MapNull(types.StringType)
+		if kubernetesVersion.FeatureGates != nil {
+			var diags diag.Diagnostics
+			featureGates, diags = types.MapValueFrom(ctx, types.StringType, *kubernetesVersion.FeatureGates)
+			if diags.HasError() {
+				return fmt.Errorf("mapping feature gates of index %d: %w", i, core.DiagsToError(diags))
+			}
+		}
+
+		kubernetesVersionTF, diags := types.ObjectValue(kubernetesVersionTypes, map[string]attr.Value{
+			"version":         types.StringPointerValue(kubernetesVersion.Version),
+			"state":           types.StringPointerValue(kubernetesVersion.State),
+			"expiration_date": timeValue(kubernetesVersion.ExpirationDate),
+			"feature_gates":   featureGates,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		kubernetesVersionsList = append(kubernetesVersionsList, kubernetesVersionTF)
+	}
+
+	kubernetesVersionsTF, diags := types.ListValue(types.ObjectType{AttrTypes: kubernetesVersionTypes}, kubernetesVersionsList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.KubernetesVersions = kubernetesVersionsTF
+	return nil
+}
+
+func mapMachineImages(machineImagesResp []ske.MachineImage, model *DataSourceModel) error {
+	machineImages := make([]ske.MachineImage, len(machineImagesResp))
+	copy(machineImages, machineImagesResp)
+	sort.SliceStable(machineImages, func(i, j int) bool {
+		return machineImages[i].GetName() < machineImages[j].GetName()
+	})
+
+	machineImagesList := []attr.Value{}
+	for i, machineImage := range machineImages {
+		versionsList := []attr.Value{}
+		for j, version := range machineImage.GetVersions() {
+			cri := []attr.Value{}
+			for _, c := range version.GetCri() {
+				cri = append(cri, types.StringValue(string(c.GetName())))
+			}
+			criTF, diags := types.ListValue(types.StringType, cri)
+			if diags.HasError() {
+				return fmt.Errorf("mapping cri of index %d, version %d: %w", i, j, core.DiagsToError(diags))
+			}
+
+			versionTF, diags := types.ObjectValue(machineImageVersionTypes, map[string]attr.Value{
+				"version":         types.StringPointerValue(version.Version),
+				"state":           types.StringPointerValue(version.State),
+				"expiration_date": timeValue(version.ExpirationDate),
+				"cri":             criTF,
+			})
+			if diags.HasError() {
+				return fmt.Errorf("mapping index %d, version %d: %w", i, j, core.DiagsToError(diags))
+			}
+			versionsList = append(versionsList, versionTF)
+		}
+		versionsTF, diags := types.ListValue(types.ObjectType{AttrTypes: machineImageVersionTypes}, versionsList)
+		if diags.HasError() {
+			return fmt.Errorf("mapping versions of index %d: %w", i, core.DiagsToError(diags))
+		}
+
+		machineImageTF, diags := types.ObjectValue(machineImageTypes, map[string]attr.Value{
+			"name":     types.StringPointerValue(machineImage.Name),
+			"versions": versionsTF,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		machineImagesList = append(machineImagesList, machineImageTF)
+	}
+
+	machineImagesTF, diags := types.ListValue(types.ObjectType{AttrTypes: machineImageTypes}, machineImagesList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.MachineImages = machineImagesTF
+	return nil
+}
+
+func mapMachineTypes(machineTypesResp []ske.MachineType, model *DataSourceModel) error {
+	machineTypes := make([]ske.MachineType, len(machineTypesResp))
+	copy(machineTypes, machineTypesResp)
+	sort.SliceStable(machineTypes, func(i, j int) bool {
+		return machineTypes[i].GetName() < machineTypes[j].GetName()
+	})
+
+	machineTypesList := []attr.Value{}
+	for i, machineType := range machineTypes {
+		machineTypeTF, diags := types.ObjectValue(machineTypeTypes, map[string]attr.Value{
+			"name":         types.StringPointerValue(machineType.Name),
+			"architecture": types.StringPointerValue(machineType.Architecture),
+			"cpu":          types.Int64PointerValue(machineType.Cpu),
+			"gpu":          types.Int64PointerValue(machineType.Gpu),
+			"memory":       types.Int64PointerValue(machineType.Memory),
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		machineTypesList = append(machineTypesList, machineTypeTF)
+	}
+
+	machineTypesTF, diags := types.ListValue(types.ObjectType{AttrTypes: machineTypeTypes}, machineTypesList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.MachineTypes = machineTypesTF
+	return nil
+}
+
+func timeValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
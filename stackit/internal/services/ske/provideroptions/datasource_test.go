@@ -0,0 +1,192 @@
+package provideroptions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	expirationDate := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description string
+		input       *ske.ProviderOptions
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&ske.ProviderOptions{},
+			DataSourceModel{
+				Id:                 types.StringValue("eu01"),
+				Region:             types.StringValue("eu01"),
+				KubernetesVersions: types.ListValueMust(types.ObjectType{AttrTypes: kubernetesVersionTypes}, []attr.Value{}),
+				MachineImages:      types.ListValueMust(types.ObjectType{AttrTypes: machineImageTypes}, []attr.Value{}),
+				MachineTypes:       types.ListValueMust(types.ObjectType{AttrTypes: machineTypeTypes}, []attr.Value{}),
+				VolumeTypes:        types.ListValueMust(types.StringType, []attr.Value{}),
+				AvailabilityZones:  types.ListValueMust(types.StringType, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&ske.ProviderOptions{
+				KubernetesVersions: &[]ske.KubernetesVersion{
+					{
+						Version:        utils.Ptr("1.31.1"),
+						State:          utils.Ptr("deprecated"),
+						ExpirationDate: &expirationDate,
+						FeatureGates: &map[string]string{
+							"gate": "true",
+						},
+					},
+					{
+						Version: utils.Ptr("1.32.0"),
+						State:   utils.Ptr("supported"),
+					},
+				},
+				MachineImages: &[]ske.MachineImage{
+					{
+						Name: utils.Ptr("ubuntu"),
+					},
+					{
+						Name: utils.Ptr("flatcar"),
+						Versions: &[]ske.MachineImageVersion{
+							{
+								Version: utils.Ptr("3815.2.1"),
+								State:   utils.Ptr("supported"),
+								Cri: &[]ske.CRI{
+									{
+										Name: ske.CRINAME_CONTAINERD.Ptr(),
+									},
+								},
+							},
+						},
+					},
+				},
+				MachineTypes: &[]ske.MachineType{
+					{
+						Name:         utils.Ptr("g1.2"),
+						Architecture: utils.Ptr("x86"),
+						Cpu:          utils.Ptr(int64(2)),
+						Gpu:          utils.Ptr(int64(0)),
+						Memory:       utils.Ptr(int64(8)),
+					},
+					{
+						Name: utils.Ptr("c1.2"),
+					},
+				},
+				VolumeTypes: &[]ske.VolumeType{
+					{
+						Name: utils.Ptr("storage_premium_perf1"),
+					},
+					{
+						Name: utils.Ptr("storage_premium_perf0"),
+					},
+				},
+				AvailabilityZones: &[]ske.AvailabilityZone{
+					{
+						Name: utils.Ptr("eu01-2"),
+					},
+					{
+						Name: utils.Ptr("eu01-1"),
+					},
+				},
+			},
+			DataSourceModel{
+				Id:     types.StringValue("eu01"),
+				Region: types.StringValue("eu01"),
+				KubernetesVersions: types.ListValueMust(types.ObjectType{AttrTypes: kubernetesVersionTypes}, []attr.Value{
+					types.ObjectValueMust(kubernetesVersionTypes, map[string]attr.Value{
+						"version":         types.StringValue("1.31.1"),
+						"state":           types.StringValue("deprecated"),
+						"expiration_date": types.StringValue("2026-03-31T00:00:00Z"),
+						"feature_gates": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"gate": types.StringValue("true"),
+						}),
+					}),
+					types.ObjectValueMust(kubernetesVersionTypes, map[string]attr.Value{
+						"version":         types.StringValue("1.32.0"),
+						"state":           types.StringValue("supported"),
+						"expiration_date": types.StringNull(),
+						"feature_gates":   types.MapNull(types.StringType),
+					}),
+				}),
+				MachineImages: types.ListValueMust(types.ObjectType{AttrTypes: machineImageTypes}, []attr.Value{
+					types.ObjectValueMust(machineImageTypes, map[string]attr.Value{
+						"name": types.StringValue("flatcar"),
+						"versions": types.ListValueMust(types.ObjectType{AttrTypes: machineImageVersionTypes}, []attr.Value{
+							types.ObjectValueMust(machineImageVersionTypes, map[string]attr.Value{
+								"version":         types.StringValue("3815.2.1"),
+								"state":           types.StringValue("supported"),
+								"expiration_date": types.StringNull(),
+								"cri": types.ListValueMust(types.StringType, []attr.Value{
+									types.StringValue("containerd"),
+								}),
+							}),
+						}),
+					}),
+					types.ObjectValueMust(machineImageTypes, map[string]attr.Value{
+						"name":     types.StringValue("ubuntu"),
+						"versions": types.ListValueMust(types.ObjectType{AttrTypes: machineImageVersionTypes}, []attr.Value{}),
+					}),
+				}),
+				MachineTypes: types.ListValueMust(types.ObjectType{AttrTypes: machineTypeTypes}, []attr.Value{
+					types.ObjectValueMust(machineTypeTypes, map[string]attr.Value{
+						"name":         types.StringValue("c1.2"),
+						"architecture": types.StringNull(),
+						"cpu":          types.Int64Null(),
+						"gpu":          types.Int64Null(),
+						"memory":       types.Int64Null(),
+					}),
+					types.ObjectValueMust(machineTypeTypes, map[string]attr.Value{
+						"name":         types.StringValue("g1.2"),
+						"architecture": types.StringValue("x86"),
+						"cpu":          types.Int64Value(2),
+						"gpu":          types.Int64Value(0),
+						"memory":       types.Int64Value(8),
+					}),
+				}),
+				VolumeTypes: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("storage_premium_perf0"),
+					types.StringValue("storage_premium_perf1"),
+				}),
+				AvailabilityZones: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("eu01-1"),
+					types.StringValue("eu01-2"),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &DataSourceModel{}
+			err := mapDataSourceFields(context.Background(), tt.input, model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
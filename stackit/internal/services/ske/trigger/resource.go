@@ -0,0 +1,335 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	skeWait "github.com/stackitcloud/stackit-sdk-go/services/ske/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+const (
+	operationMaintenance = "maintenance"
+	operationReconcile   = "reconcile"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &clusterTriggerResource{}
+	_ resource.ResourceWithConfigure  = &clusterTriggerResource{}
+	_ resource.ResourceWithModifyPlan = &clusterTriggerResource{}
+
+	operationOptions = []string{operationMaintenance, operationReconcile}
+)
+
+type Model struct {
+	Id                    types.String `tfsdk:"id"` // needed by TF
+	ProjectId             types.String `tfsdk:"project_id"`
+	Region                types.String `tfsdk:"region"`
+	ClusterName           types.String `tfsdk:"cluster_name"`
+	Operation             types.String `tfsdk:"operation"`
+	Trigger               types.String `tfsdk:"trigger"`
+	Status                types.String `tfsdk:"status"`
+	KubernetesVersionUsed types.String `tfsdk:"kubernetes_version_used"`
+}
+
+// NewClusterTriggerResource is a helper function to simplify the provider implementation.
+func NewClusterTriggerResource() resource.Resource {
+	return &clusterTriggerResource{}
+}
+
+// clusterTriggerResource is the resource implementation.
+type clusterTriggerResource struct {
+	client       *ske.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *clusterTriggerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_cluster_trigger"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *clusterTriggerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := skeUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "SKE cluster trigger client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *clusterTriggerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "SKE cluster trigger resource schema. Runs a maintenance or reconciliation of the cluster on creation and whenever `trigger` changes, e.g. to roll out Kubernetes and OS image updates outside of the maintenance window. Must have a `region` specified in the provider configuration.",
+		"note": "Deleting this resource only removes it from the Terraform state.",
+		"id":   "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`cluster_name`,`operation`\".",
+		"operation": "The operation to run. `maintenance` applies pending Kubernetes and OS image updates immediately, as if the maintenance window had started. `reconcile` reconciles the cluster with its specification. " +
+			utils.SupportedValuesDocumentation(operationOptions),
+		"trigger":                 "Arbitrary value that runs the operation again when changed, e.g. a timestamp.",
+		"project_id":              "STACKIT project ID to which the cluster is associated.",
+		"cluster_name":            "Name of the SKE cluster.",
+		"region":                  "The resource region. If not defined, the provider region is used.",
+		"status":                  "Aggregated status of the cluster.",
+		"kubernetes_version_used": "Full Kubernetes version used by the cluster.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("%s\n%s", descriptions["main"], descriptions["note"]),
+		// Callout block: https://developer.hashicorp.com/terraform/registry/providers/docs#callouts
+		MarkdownDescription: fmt.Sprintf("%s\n\n-> %s", descriptions["main"], descriptions["note"]),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				Description: descriptions["cluster_name"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: descriptions["region"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation": schema.StringAttribute{
+				Description: descriptions["operation"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(operationOptions...),
+				},
+			},
+			"trigger": schema.StringAttribute{
+				Description: descriptions["trigger"],
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
+			"kubernetes_version_used": schema.StringAttribute{
+				Description: descriptions["kubernetes_version_used"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *clusterTriggerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create runs the operation and sets the initial Terraform state.
+func (r *clusterTriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	region := model.Region.ValueString()
+	operation := model.Operation.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "operation", operation)
+
+	var err error
+	var waitHandler *wait.AsyncActionHandler[ske.Cluster]
+	switch operation {
+	case operationMaintenance:
+		_, err = r.client.TriggerMaintenance(ctx, projectId, region, clusterName).Execute()
+		waitHandler = skeWait.TriggerClusterMaintenanceWaitHandler(ctx, r.client, projectId, region, clusterName)
+	case operationReconcile:
+		_, err = r.client.TriggerReconcile(ctx, projectId, region, clusterName).Execute()
+		waitHandler = skeWait.TriggerClusterReconciliationWaitHandler(ctx, r.client, projectId, region, clusterName)
+	default:
+		err = fmt.Errorf("unsupported operation %q", operation)
+	}
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error triggering cluster operation", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	cluster, err := waitHandler.WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error triggering cluster operation", fmt.Sprintf("Cluster %s waiting: %v", operation, err))
+		return
+	}
+
+	err = mapFields(cluster, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error triggering cluster operation", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE cluster operation triggered")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *clusterTriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	cluster, err := r.client.GetCluster(ctx, projectId, region, clusterName).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading cluster trigger", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(cluster, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading cluster trigger", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE cluster trigger read")
+}
+
+func (r *clusterTriggerResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Update shouldn't be called
+	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating cluster trigger", "Cluster trigger can't be updated")
+}
+
+// Delete removes the resource from the Terraform state. A triggered operation can't be undone.
+func (r *clusterTriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "project_id", model.ProjectId.ValueString())
+	ctx = tflog.SetField(ctx, "cluster_name", model.ClusterName.ValueString())
+	ctx = tflog.SetField(ctx, "region", model.Region.ValueString())
+
+	// the trigger is removed from the state automatically
+	tflog.Info(ctx, "SKE cluster trigger deleted")
+}
+
+func mapFields(cluster *ske.Cluster, model *Model, region string) error {
+	if cluster == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.ClusterName.ValueString(), model.Operation.ValueString())
+	model.Region = types.StringValue(region)
+
+	model.Status = types.StringNull()
+	if cluster.Status != nil && cluster.Status.Aggregated != nil {
+		model.Status = types.StringValue(string(*cluster.Status.Aggregated))
+	}
+	model.KubernetesVersionUsed = types.StringNull()
+	if cluster.Kubernetes != nil {
+		model.KubernetesVersionUsed = types.StringPointerValue(cluster.Kubernetes.Version)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package ske
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+const testRegion = "eu01"
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       Model
+		input       *ske.Cluster
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			Model{
+				ProjectId:   types.StringValue("pid"),
+				ClusterName: types.StringValue("cluster"),
+				Operation:   types.StringValue(operationMaintenance),
+			},
+			&ske.Cluster{},
+			Model{
+				Id:                    types.StringValue("pid,eu01,cluster,maintenance"),
+				ProjectId:             types.StringValue("pid"),
+				Region:                types.StringValue(testRegion),
+				ClusterName:           types.StringValue("cluster"),
+				Operation:             types.StringValue(operationMaintenance),
+				Status:                types.StringNull(),
+				KubernetesVersionUsed: types.StringNull(),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			Model{
+				ProjectId:   types.StringValue("pid"),
+				ClusterName: types.StringValue("cluster"),
+				Operation:   types.StringValue(operationReconcile),
+				Trigger:     types.StringValue("trigger"),
+			},
+			&ske.Cluster{
+				Kubernetes: &ske.Kubernetes{
+					Version: utils.Ptr("1.31.4"),
+				},
+				Status: &ske.ClusterStatus{
+					Aggregated: ske.CLUSTERSTATUSSTATE_HEALTHY.Ptr(),
+				},
+			},
+			Model{
+				Id:                    types.StringValue("pid,eu01,cluster,reconcile"),
+				ProjectId:             types.StringValue("pid"),
+				Region:                types.StringValue(testRegion),
+				ClusterName:           types.StringValue("cluster"),
+				Operation:             types.StringValue(operationReconcile),
+				Trigger:               types.StringValue("trigger"),
+				Status:                types.StringValue("STATE_HEALTHY"),
+				KubernetesVersionUsed: types.StringValue("1.31.4"),
+			},
+			true,
+		},
+		{
+			"nil_response",
+			Model{},
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, &tt.state, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// MaxUserDataSize is the maximum size of the base64 encoded user data accepted by the IaaS API
+	MaxUserDataSize = 65535
+
+	cloudConfigHeader   = "#cloud-config"
+	cloudConfigType     = "text/cloud-config"
+	multipartTypePrefix = "multipart/"
+)
+
+// DecodeUserData returns the content of server user data, which is either plain text or base64 encoded
+// (and optionally gzip compressed). encoded reports whether the user data was base64 encoded.
+// Base64 values are only treated as encoded when their decoded content looks like cloud-init user data,
+// so that plain text which happens to be valid base64 is passed on unchanged.
+func DecodeUserData(userData string) (content []byte, encoded bool, err error) {
+	decoded, err := base64.StdEncoding.DecodeString(userData)
+	if err != nil || len(decoded) == 0 {
+		return []byte(userData), false, nil
+	}
+	if bytes.HasPrefix(decoded, []byte{0x1f, 0x8b}) {
+		reader, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err != nil {
+			return nil, true, fmt.Errorf("decompressing gzip user data: %w", err)
+		}
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, true, fmt.Errorf("decompressing gzip user data: %w", err)
+		}
+		return content, true, nil
+	}
+	if bytes.HasPrefix(decoded, []byte("#")) || bytes.HasPrefix(decoded, []byte("Content-Type:")) {
+		return decoded, true, nil
+	}
+	return []byte(userData), false, nil
+}
+
+// UserDataPayload returns the base64 encoded user data expected by the IaaS API.
+// User data which is already base64 encoded is passed on unchanged.
+func UserDataPayload(userData string) []byte {
+	if _, encoded, err := DecodeUserData(userData); err == nil && encoded {
+		return []byte(userData)
+	}
+	src := []byte(userData)
+	encodedUserData := make([]byte, base64.StdEncoding.EncodedLen(len(src)))
+	base64.StdEncoding.Encode(encodedUserData, src)
+	return encodedUserData
+}
+
+// CheckUserData checks that server user data can be decoded, doesn't exceed the size accepted by the API
+// and that `#cloud-config` documents, also as part of a multipart archive, are valid YAML.
+func CheckUserData(userData string) error {
+	content, encoded, err := DecodeUserData(userData)
+	if err != nil {
+		return err
+	}
+
+	size := len(userData)
+	if !encoded {
+		size = base64.StdEncoding.EncodedLen(len(userData))
+	}
+	if size > MaxUserDataSize {
+		return fmt.Errorf("user data is %d bytes after base64 encoding, the maximum is %d bytes. Consider compressing it with gzip, e.g. by rendering it with the stackit_cloudinit_config data source", size, MaxUserDataSize)
+	}
+
+	return checkUserDataContent(content)
+}
+
+func checkUserDataContent(content []byte) error {
+	if bytes.HasPrefix(content, []byte(cloudConfigHeader)) {
+		return CheckCloudConfig(content)
+	}
+	if !bytes.HasPrefix(content, []byte("Content-Type:")) {
+		return nil
+	}
+
+	header, body, found := strings.Cut(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n\n")
+	if !found {
+		return fmt.Errorf("MIME user data has no body")
+	}
+	contentType := textproto.MIMEHeader{}
+	for _, line := range strings.Split(header, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if ok {
+			contentType.Add(strings.TrimSpace(key), strings.TrimSpace(value))
+		}
+	}
+	mediaType, params, err := mime.ParseMediaType(contentType.Get("Content-Type"))
+	if err != nil {
+		return fmt.Errorf("parsing MIME content type: %w", err)
+	}
+	if !strings.HasPrefix(mediaType, multipartTypePrefix) {
+		return nil
+	}
+
+	reader := multipart.NewReader(strings.NewReader(body), params["boundary"])
+	for i := 0; ; i++ {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading MIME part %d: %w", i, err)
+		}
+		partType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		if err != nil {
+			return fmt.Errorf("parsing content type of MIME part %d: %w", i, err)
+		}
+		if partType != cloudConfigType || part.Header.Get("Content-Transfer-Encoding") == "base64" {
+			continue
+		}
+		partContent, err := io.ReadAll(part)
+		if err != nil {
+			return fmt.Errorf("reading MIME part %d: %w", i, err)
+		}
+		if err := CheckCloudConfig(partContent); err != nil {
+			return fmt.Errorf("MIME part %d: %w", i, err)
+		}
+	}
+}
+
+// CheckCloudConfig checks that a cloud-config document is a valid YAML mapping
+func CheckCloudConfig(content []byte) error {
+	var document any
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return fmt.Errorf("invalid %s YAML: %w", cloudConfigHeader, err)
+	}
+	if document == nil {
+		return nil
+	}
+	if _, ok := document.(map[string]any); !ok {
+		return fmt.Errorf("invalid %s: the document must be a YAML mapping", cloudConfigHeader)
+	}
+	return nil
+}
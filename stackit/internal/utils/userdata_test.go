@@ -0,0 +1,201 @@
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func gzipBase64(t *testing.T, content string) string {
+	t.Helper()
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	if _, err := writer.Write([]byte(content)); err != nil {
+		t.Fatalf("compressing: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("compressing: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(buffer.Bytes())
+}
+
+func TestDecodeUserData(t *testing.T) {
+	tests := []struct {
+		description     string
+		input           string
+		expectedContent string
+		expectedEncoded bool
+		isValid         bool
+	}{
+		{
+			"plain_text",
+			"#!/bin/bash\necho hello",
+			"#!/bin/bash\necho hello",
+			false,
+			true,
+		},
+		{
+			"plain_text_valid_base64",
+			"test",
+			"test",
+			false,
+			true,
+		},
+		{
+			"base64_cloud_config",
+			base64.StdEncoding.EncodeToString([]byte("#cloud-config\npackages: []\n")),
+			"#cloud-config\npackages: []\n",
+			true,
+			true,
+		},
+		{
+			"base64_mime",
+			base64.StdEncoding.EncodeToString([]byte("Content-Type: multipart/mixed; boundary=\"B\"\n")),
+			"Content-Type: multipart/mixed; boundary=\"B\"\n",
+			true,
+			true,
+		},
+		{
+			"gzip_base64",
+			gzipBase64(t, "#cloud-config\n"),
+			"#cloud-config\n",
+			true,
+			true,
+		},
+		{
+			"corrupt_gzip",
+			base64.StdEncoding.EncodeToString([]byte{0x1f, 0x8b, 0x00}),
+			"",
+			true,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			content, encoded, err := DecodeUserData(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(string(content), tt.expectedContent)
+				if diff != "" {
+					t.Fatalf("Content does not match: %s", diff)
+				}
+				if encoded != tt.expectedEncoded {
+					t.Fatalf("Expected encoded to be %t, got %t", tt.expectedEncoded, encoded)
+				}
+			}
+		})
+	}
+}
+
+func TestUserDataPayload(t *testing.T) {
+	encoded := gzipBase64(t, "#cloud-config\n")
+	tests := []struct {
+		description string
+		input       string
+		expected    string
+	}{
+		{
+			"plain_text",
+			"user_data",
+			"dXNlcl9kYXRh",
+		},
+		{
+			"already_encoded",
+			encoded,
+			encoded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := UserDataPayload(tt.input)
+			diff := cmp.Diff(string(output), tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckUserData(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"script",
+			"#!/bin/bash\necho hello",
+			true,
+		},
+		{
+			"valid_cloud_config",
+			"#cloud-config\npackages:\n  - nginx\n",
+			true,
+		},
+		{
+			"empty_cloud_config",
+			"#cloud-config\n",
+			true,
+		},
+		{
+			"invalid_cloud_config_yaml",
+			"#cloud-config\npackages: [nginx\n",
+			false,
+		},
+		{
+			"cloud_config_not_a_mapping",
+			"#cloud-config\n- nginx\n",
+			false,
+		},
+		{
+			"invalid_cloud_config_gzip_base64",
+			gzipBase64(t, "#cloud-config\npackages: [nginx\n"),
+			false,
+		},
+		{
+			"valid_multipart",
+			"Content-Type: multipart/mixed; boundary=\"B\"\nMIME-Version: 1.0\n\n--B\nContent-Type: text/cloud-config\n\npackages:\n  - nginx\n--B\nContent-Type: text/x-shellscript\n\n#!/bin/bash\n--B--\n",
+			true,
+		},
+		{
+			"invalid_multipart_cloud_config",
+			"Content-Type: multipart/mixed; boundary=\"B\"\nMIME-Version: 1.0\n\n--B\nContent-Type: text/cloud-config\n\npackages: [nginx\n--B--\n",
+			false,
+		},
+		{
+			"corrupt_gzip",
+			base64.StdEncoding.EncodeToString([]byte{0x1f, 0x8b, 0x00}),
+			false,
+		},
+		{
+			"maximum_size",
+			strings.Repeat("a", MaxUserDataSize/4*3),
+			true,
+		},
+		{
+			"too_large",
+			strings.Repeat("a", MaxUserDataSize),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := CheckUserData(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/netip"
 	"os"
 	"regexp"
 	"strings"
@@ -88,6 +89,26 @@ func IP(allowZeroAddress bool) *Validator {
 	}
 }
 
+// IPv4 returns a validator that checks, if the given string is a valid IPv4 address.
+// The allowZeroAddress parameter defines, if 0.0.0.0 should be considered valid.
+func IPv4(allowZeroAddress bool) *Validator {
+	description := "value must be an IPv4 address"
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			ip, err := netip.ParseAddr(req.ConfigValue.ValueString())
+			if err != nil || !ip.Is4() || (!allowZeroAddress && ip.IsUnspecified()) {
+				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					description,
+					req.ConfigValue.ValueString(),
+				))
+			}
+		},
+	}
+}
+
 func RecordSet() *Validator {
 	const typePath = "type"
 	return &Validator{
@@ -344,3 +365,23 @@ func ValidNoTrailingNewline() *Validator {
 		},
 	}
 }
+
+// UserData returns a Validator that checks server user data at plan time: the value must be plain text or
+// base64 encoded (optionally gzip compressed), must not exceed the size accepted by the API and
+// `#cloud-config` documents must be valid YAML. The value itself is not part of the diagnostic, as it can be large.
+func UserData() *Validator {
+	description := fmt.Sprintf("value must be plain text or base64 encoded cloud-init user data of at most %d bytes after base64 encoding, #cloud-config documents must be valid YAML", utils.MaxUserDataSize)
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if err := utils.CheckUserData(req.ConfigValue.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Invalid Attribute Value",
+					fmt.Sprintf("Attribute %s %s, got: %v", req.Path, description, err),
+				)
+			}
+		},
+	}
+}
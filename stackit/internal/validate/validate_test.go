@@ -2,6 +2,7 @@ package validate
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -134,6 +135,18 @@ func TestIP(t *testing.T) {
 			"0000:0000:0000:0000:0000:0000:0000:0000",
 			false,
 		},
+		{
+			"ipv4 prefix",
+			false,
+			"111.222.111.222/24",
+			false,
+		},
+		{
+			"ipv6 prefix",
+			false,
+			"2001:db8::/64",
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
@@ -152,6 +165,85 @@ func TestIP(t *testing.T) {
 	}
 }
 
+func TestIPv4(t *testing.T) {
+	tests := []struct {
+		description string
+		invalidZero bool
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok IP4",
+			false,
+			"111.222.111.222",
+			true,
+		},
+		{
+			"IP6",
+			false,
+			"2001:0db8:85a3:08d3::0370:7344",
+			false,
+		},
+		{
+			"IPv4-mapped IP6",
+			false,
+			"::ffff:111.222.111.222",
+			false,
+		},
+		{
+			"too short",
+			false,
+			"0.1.2",
+			false,
+		},
+		{
+			"Empty",
+			false,
+			"",
+			false,
+		},
+		{
+			"Not an IP",
+			false,
+			"for-sure-not-an-IP",
+			false,
+		},
+		{
+			"valid ipv4 zero",
+			true,
+			"0.0.0.0",
+			true,
+		},
+		{
+			"invalid ipv4 zero",
+			false,
+			"0.0.0.0",
+			false,
+		},
+		{
+			"ipv4 prefix",
+			false,
+			"111.222.111.222/24",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			IPv4(tt.invalidZero).ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
 func TestRecordSet(t *testing.T) {
 	tests := []struct {
 		description string
@@ -916,3 +1008,48 @@ func TestValidNoTrailingNewline(t *testing.T) {
 		})
 	}
 }
+
+func TestUserData(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok",
+			"#!/bin/bash\necho hello",
+			true,
+		},
+		{
+			"ok_cloud_config",
+			"#cloud-config\npackages:\n  - nginx\n",
+			true,
+		},
+		{
+			"invalid_cloud_config",
+			"#cloud-config\npackages: [nginx\n",
+			false,
+		},
+		{
+			"too_large",
+			strings.Repeat("a", 65535),
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			UserData().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -25,19 +26,31 @@ import (
 	dnsZone "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/zone"
 	gitInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/instance"
 	iaasAffinityGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/affinitygroup"
+	iaasAffinityGroups "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/affinitygroups"
+	iaasAvailabilityZone "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/availabilityzone"
+	iaasCloudInit "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/cloudinit"
 	iaasImage "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/image"
 	iaasKeyPair "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/keypair"
+	iaasMachineType "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/machinetype"
 	iaasNetwork "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/network"
 	iaasNetworkArea "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/networkarea"
+	iaasNetworkAreaProjects "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/networkareaprojects"
 	iaasNetworkAreaRoute "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/networkarearoute"
+	iaasNetworkAreaRoutes "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/networkarearoutes"
+	iaasNetworkAreas "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/networkareas"
 	iaasNetworkInterface "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/networkinterface"
 	iaasNetworkInterfaceAttach "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/networkinterfaceattach"
+	iaasNetworks "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/networks"
 	iaasPublicIp "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/publicip"
 	iaasPublicIpAssociate "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/publicipassociate"
 	iaasPublicIpRanges "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/publicipranges"
+	iaasPublicIps "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/publicips"
 	iaasSecurityGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/securitygroup"
 	iaasSecurityGroupRule "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/securitygrouprule"
+	iaasSecurityGroupRules "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/securitygrouprules"
+	iaasSecurityGroups "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/securitygroups"
 	iaasServer "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/server"
+	iaasServerGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/servergroup"
 	iaasServiceAccountAttach "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/serviceaccountattach"
 	iaasVolume "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/volume"
 	iaasVolumeAttach "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/volumeattach"
@@ -80,14 +93,18 @@ import (
 	serviceAccountKey "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceaccount/key"
 	serviceAccountToken "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceaccount/token"
 	skeCluster "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/cluster"
+	skeCredentialsRotation "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/credentialsrotation"
 	skeKubeconfig "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/kubeconfig"
+	skeProviderOptions "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/provideroptions"
+	skeTrigger "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/trigger"
 	sqlServerFlexInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/instance"
 	sqlServerFlexUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/user"
 )
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &Provider{}
+	_ provider.Provider                       = &Provider{}
+	_ provider.ProviderWithEphemeralResources = &Provider{}
 )
 
 // Provider is the provider implementation.
@@ -440,10 +457,18 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	providerData.RoundTripper = roundTripper
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
 
 	providerData.Version = p.version
 }
 
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		skeKubeconfig.NewKubeconfigEphemeralResource,
+	}
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
@@ -454,22 +479,33 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		dnsRecordSet.NewRecordSetDataSource,
 		gitInstance.NewGitDataSource,
 		iaasAffinityGroup.NewAffinityGroupDatasource,
+		iaasAffinityGroups.NewAffinityGroupsDataSource,
+		iaasAvailabilityZone.NewAvailabilityZonesDataSource,
+		iaasCloudInit.NewCloudInitConfigDataSource,
 		iaasImage.NewImageDataSource,
 		iaasNetwork.NewNetworkDataSource,
 		iaasNetworkArea.NewNetworkAreaDataSource,
 		iaasNetworkAreaRoute.NewNetworkAreaRouteDataSource,
+		iaasNetworkAreaRoutes.NewNetworkAreaRoutesDataSource,
+		iaasNetworkAreaProjects.NewNetworkAreaProjectsDataSource,
+		iaasNetworkAreas.NewNetworkAreasDataSource,
 		iaasNetworkInterface.NewNetworkInterfaceDataSource,
+		iaasNetworks.NewNetworksDataSource,
 		iaasVolume.NewVolumeDataSource,
 		iaasPublicIp.NewPublicIpDataSource,
+		iaasPublicIps.NewPublicIpsDataSource,
 		iaasPublicIpRanges.NewPublicIpRangesDataSource,
 		iaasKeyPair.NewKeyPairDataSource,
+		iaasMachineType.NewMachineTypesDataSource,
 		iaasServer.NewServerDataSource,
 		iaasSecurityGroup.NewSecurityGroupDataSource,
+		iaasSecurityGroups.NewSecurityGroupsDataSource,
 		iaasalphaRoutingTable.NewRoutingTableDataSource,
 		iaasalphaRoutingTableRoute.NewRoutingTableRouteDataSource,
 		iaasalphaRoutingTables.NewRoutingTablesDataSource,
 		iaasalphaRoutingTableRoutes.NewRoutingTableRoutesDataSource,
 		iaasSecurityGroupRule.NewSecurityGroupRuleDataSource,
+		iaasSecurityGroupRules.NewSecurityGroupRulesDataSource,
 		loadBalancer.NewLoadBalancerDataSource,
 		logMeInstance.NewInstanceDataSource,
 		logMeCredential.NewCredentialDataSource,
@@ -503,6 +539,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		serverUpdateSchedule.NewSchedulesDataSource,
 		serviceAccount.NewServiceAccountDataSource,
 		skeCluster.NewClusterDataSource,
+		skeProviderOptions.NewProviderOptionsDataSource,
 	}
 }
 
@@ -520,6 +557,7 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		iaasNetwork.NewNetworkResource,
 		iaasNetworkArea.NewNetworkAreaResource,
 		iaasNetworkAreaRoute.NewNetworkAreaRouteResource,
+		iaasNetworkAreaRoutes.NewNetworkAreaRoutesResource,
 		iaasNetworkInterface.NewNetworkInterfaceResource,
 		iaasVolume.NewVolumeResource,
 		iaasPublicIp.NewPublicIpResource,
@@ -529,8 +567,10 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		iaasServiceAccountAttach.NewServiceAccountAttachResource,
 		iaasPublicIpAssociate.NewPublicIpAssociateResource,
 		iaasServer.NewServerResource,
+		iaasServerGroup.NewServerGroupResource,
 		iaasSecurityGroup.NewSecurityGroupResource,
 		iaasSecurityGroupRule.NewSecurityGroupRuleResource,
+		iaasSecurityGroupRules.NewSecurityGroupRulesResource,
 		iaasalphaRoutingTable.NewRoutingTableResource,
 		iaasalphaRoutingTableRoute.NewRoutingTableRouteResource,
 		loadBalancer.NewLoadBalancerResource,
@@ -569,7 +609,10 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		serviceAccountToken.NewServiceAccountTokenResource,
 		serviceAccountKey.NewServiceAccountKeyResource,
 		skeCluster.NewClusterResource,
+		skeCluster.NewNodePoolResource,
+		skeCredentialsRotation.NewCredentialsRotationResource,
 		skeKubeconfig.NewKubeconfigResource,
+		skeTrigger.NewClusterTriggerResource,
 	}
 	resources = append(resources, roleAssignements.NewRoleAssignmentResources()...)
 